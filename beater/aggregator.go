@@ -0,0 +1,166 @@
+package beater
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+// series aggregates the metrics of one bucket, tag set and type.
+type series interface {
+	add(m *metric)
+	// fields returns the statsd fields for the interval that just ended.
+	fields(interval time.Duration) common.MapStr
+}
+
+type aggregate struct {
+	bucket string
+	tags   map[string]interface{}
+	series series
+	route  routeIndex
+}
+
+// aggregator collects the metrics that are published once per flush
+// interval. It is not safe for concurrent use.
+type aggregator struct {
+	config config.Config
+	series map[string]*aggregate
+	gauges map[string]*gauge
+	start  time.Time
+}
+
+func newAggregator(c config.Config) *aggregator {
+	return &aggregator{
+		config: c,
+		series: map[string]*aggregate{},
+		gauges: map[string]*gauge{},
+		start:  time.Now(),
+	}
+}
+
+// add keeps the metric when it is aggregated. It returns false when the
+// metric must be published as is.
+func (a *aggregator) add(m *metric) bool {
+	if m._type == "g" {
+		return a.addGauge(m)
+	}
+	key := m._type + "|" + routeKey(m.route) + seriesKey(m.bucket, m.tags)
+	agg, ok := a.series[key]
+	if !ok {
+		s := a.newSeries(m)
+		if s == nil {
+			return false
+		}
+		agg = &aggregate{bucket: m.bucket, tags: m.tags, series: s, route: m.route}
+		a.series[key] = agg
+	}
+	agg.series.add(m)
+	return true
+}
+
+// newSeries returns nil for metrics that are not aggregated.
+func (a *aggregator) newSeries(m *metric) series {
+	switch m._type {
+	case "s":
+		return newSet(a.config.Sets)
+	case "c":
+		if a.config.Aggregation.Counters {
+			return &counter{}
+		}
+	case "ms", "h":
+		if a.config.Aggregation.Timers {
+			return newTimer(m._type, a.config.Aggregation.Percentiles)
+		}
+	}
+	return nil
+}
+
+// flush returns one event per aggregated series and starts a new interval.
+func (a *aggregator) flush(now time.Time) []beat.Event {
+	interval := now.Sub(a.start)
+	if interval <= 0 {
+		interval = a.config.Period
+	}
+	a.start = now
+
+	keys := make([]string, 0, len(a.series))
+	for k := range a.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	events := make([]beat.Event, 0, len(keys))
+	for _, key := range keys {
+		agg := a.series[key]
+		e := beat.Event{
+			Timestamp: now,
+			Fields:    bucketFields(agg.bucket, agg.tags),
+			Private:   agg.route,
+		}
+		e.Fields.Update(agg.series.fields(interval))
+		events = append(events, e)
+	}
+	a.series = map[string]*aggregate{}
+	return append(events, a.flushGauges(now)...)
+}
+
+// counter sums the (sample rate scaled) values of a counter.
+type counter struct {
+	sum float64
+}
+
+func (c *counter) add(m *metric) {
+	c.sum += m.value * (1 / m.sampleRate)
+}
+
+func (c *counter) fields(interval time.Duration) common.MapStr {
+	return common.MapStr{
+		"statsd.type":  "counter",
+		"statsd.value": counterValue(c.sum),
+		"statsd.rate":  c.sum / interval.Seconds(),
+	}
+}
+
+// routeKey keeps the series of different clients apart, a metric of an input
+// that may drop events never joins a series that is guaranteed. The global
+// route has no key.
+func routeKey(route routeIndex) string {
+	if route == 0 {
+		return ""
+	}
+	return strconv.Itoa(int(route)) + "|"
+}
+
+// seriesKey identifies a bucket with its tags, independent of the tag order.
+func seriesKey(bucket string, tags map[string]interface{}) string {
+	if len(tags) == 0 {
+		return bucket
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	sb.WriteString(bucket)
+	for _, k := range keys {
+		sb.WriteByte(',')
+		sb.WriteString(k)
+		sb.WriteByte('=')
+		switch v := tags[k].(type) {
+		case string:
+			sb.WriteString(v)
+		default:
+			fmt.Fprint(&sb, v)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,215 @@
+package beater
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+func flushAggregated(t *testing.T, c config.Config, msg string) []common.MapStr {
+	t.Helper()
+	metrics, _, err := parseMessage(msg, config.DialectInfluxDB)
+	if err != nil {
+		t.Fatalf("parseMessage() error = %v", err)
+	}
+	a := newAggregator(c)
+	for i := range metrics {
+		if !a.add(&metrics[i]) {
+			t.Fatalf("aggregator.add() did not keep %v", metrics[i])
+		}
+	}
+	result := []common.MapStr{}
+	for _, e := range a.flush(a.start.Add(10 * time.Second)) {
+		result = append(result, e.Fields.Flatten())
+	}
+	if len(a.flush(time.Now())) != 0 {
+		t.Errorf("aggregator.flush() did not start a new interval")
+	}
+	return result
+}
+
+func Test_aggregatorSets(t *testing.T) {
+	capped := config.DefaultConfig
+	capped.Sets = config.SetConfig{MaxMembers: 2, Mode: config.SetModeExact}
+
+	tests := []struct {
+		name   string
+		config config.Config
+		msg    string
+		want   []common.MapStr
+	}{
+		{"unique",
+			config.DefaultConfig,
+			"users:a|s\nusers:b|s\nusers:a|s",
+			[]common.MapStr{
+				{
+					"statsd.bucket": "users",
+					"statsd.target": "users",
+					"statsd.type":   "set",
+					"statsd.value":  int64(2),
+				},
+			},
+		},
+		{"perTagSet",
+			config.DefaultConfig,
+			"users,env=prod,dc=eu:a|s\nusers,dc=eu,env=prod:b|s\nusers,env=test:a|s",
+			[]common.MapStr{
+				{
+					"statsd.bucket":  "users",
+					"statsd.target":  "users",
+					"statsd.type":    "set",
+					"statsd.value":   int64(2),
+					"statsd.ctx.dc":  "eu",
+					"statsd.ctx.env": "prod",
+				},
+				{
+					"statsd.bucket":  "users",
+					"statsd.target":  "users",
+					"statsd.type":    "set",
+					"statsd.value":   int64(1),
+					"statsd.ctx.env": "test",
+				},
+			},
+		},
+		{"capped",
+			capped,
+			"users:a|s\nusers:b|s\nusers:c|s\nusers:a|s",
+			[]common.MapStr{
+				{
+					"statsd.bucket":    "users",
+					"statsd.target":    "users",
+					"statsd.type":      "set",
+					"statsd.value":     int64(2),
+					"statsd.truncated": true,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := flushAggregated(t, tt.config, tt.msg)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("aggregator.flush() = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_aggregatorCounters(t *testing.T) {
+	c := config.DefaultConfig
+	c.Aggregation.Counters = true
+
+	got := flushAggregated(t, c, "requests:1|c\nrequests:1|c\nrequests:2|c|@0.5\nerrors,code=500:3|c\nrequests:2|c")
+	want := []common.MapStr{
+		{
+			"statsd.bucket":   "errors",
+			"statsd.target":   "errors",
+			"statsd.type":     "counter",
+			"statsd.value":    int64(3),
+			"statsd.rate":     0.3,
+			"statsd.ctx.code": "500",
+		},
+		{
+			"statsd.bucket": "requests",
+			"statsd.target": "requests",
+			"statsd.type":   "counter",
+			"statsd.value":  int64(8),
+			"statsd.rate":   0.8,
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("aggregator.flush() = \n%v, want \n%v", got, want)
+	}
+
+	a := newAggregator(config.DefaultConfig)
+	if a.add(&metric{bucket: "requests", _type: "c", value: 1, sampleRate: 1}) {
+		t.Errorf("aggregator.add() kept a counter while counter aggregation is disabled")
+	}
+}
+
+func Test_aggregatorGaugeDeltas(t *testing.T) {
+	metrics, _, err := parseMessage("gas_tank:0.50|g\ngas_tank:+0.50|g\ngas_tank:-0.75|g\ngas_tank:+0|g\ngas_tank:-0|g\ngas_tank:0|g\ngas_tank:-3|g", config.DialectInfluxDB)
+	if err != nil {
+		t.Fatalf("parseMessage() error = %v", err)
+	}
+	want := []float64{0.5, 1, 0.25, 0.25, 0.25, 0, -3}
+
+	a := newAggregator(config.DefaultConfig)
+	for i := range metrics {
+		if a.add(&metrics[i]) {
+			t.Errorf("aggregator.add() kept a gauge while gauges.repeat is disabled")
+		}
+		if metrics[i].value != want[i] {
+			t.Errorf("gauge value after %d metrics = %v, want %v", i+1, metrics[i].value, want[i])
+		}
+	}
+	if events := a.flush(time.Now()); len(events) != 0 {
+		t.Errorf("aggregator.flush() = %v, want no events", events)
+	}
+}
+
+func Test_aggregatorGaugeRepeat(t *testing.T) {
+	c := config.DefaultConfig
+	c.Gauges = config.GaugeConfig{Repeat: true, IdleTTL: time.Minute}
+
+	a := newAggregator(c)
+	now := time.Now()
+	m := metric{timestamp: now, bucket: "gas_tank", _type: "g", value: 0.5, sampleRate: 1}
+	if !a.add(&m) {
+		t.Fatalf("aggregator.add() did not keep a gauge while gauges.repeat is enabled")
+	}
+
+	for _, flush := range []time.Duration{time.Second, 30 * time.Second, time.Minute} {
+		events := a.flush(now.Add(flush))
+		if len(events) != 1 {
+			t.Fatalf("aggregator.flush() after %v returned %d events, want 1", flush, len(events))
+		}
+		if v, _ := events[0].Fields.GetValue("statsd.value"); v != 0.5 {
+			t.Errorf("aggregator.flush() after %v statsd.value = %v, want 0.5", flush, v)
+		}
+	}
+	if events := a.flush(now.Add(time.Minute + time.Second)); len(events) != 0 {
+		t.Errorf("aggregator.flush() after the idle TTL = %v, want no events", events)
+	}
+	if len(a.gauges) != 0 {
+		t.Errorf("aggregator kept %d gauges after the idle TTL", len(a.gauges))
+	}
+}
+
+func Test_aggregatorForgetsIdleGaugesByDefault(t *testing.T) {
+	a := newAggregator(config.DefaultConfig)
+	now := time.Now()
+	m := metric{timestamp: now, bucket: "container", tags: map[string]interface{}{"id": "3f2a"}, _type: "g", value: 1, sampleRate: 1}
+	a.add(&m)
+
+	a.flush(now.Add(time.Minute))
+	if len(a.gauges) != 1 {
+		t.Fatalf("aggregator kept %d gauges, want 1", len(a.gauges))
+	}
+	a.flush(now.Add(config.DefaultConfig.Gauges.IdleTTL + time.Second))
+	if len(a.gauges) != 0 {
+		t.Errorf("aggregator kept %d gauges after the default idle TTL", len(a.gauges))
+	}
+}
+
+func Test_setHyperLogLog(t *testing.T) {
+	c := config.SetConfig{MaxMembers: 100, Mode: config.SetModeHyperLogLog}
+	s := newSet(c)
+	const n = 50000
+	for i := 0; i < n; i++ {
+		s.add(&metric{member: string(rune('a'+i%26)) + strconv.Itoa(i)})
+		s.add(&metric{member: "a0"})
+	}
+	if s.hll == nil || s.members != nil {
+		t.Fatalf("set did not switch to hyperloglog beyond max_members")
+	}
+	if got := s.count(); math.Abs(float64(got-n))/n > 0.03 {
+		t.Errorf("set.count() = %v, want %v within 3%%", got, n)
+	}
+}
@@ -0,0 +1,47 @@
+//go:build linux
+// +build linux
+
+package beater
+
+import (
+	"net"
+
+	"golang.org/x/net/ipv4"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// readBatches reads up to read_batch datagrams per recvmmsg syscall, into
+// buffers that are reused for every batch. It returns false without reading
+// when the conn does not support batched reads, e.g. a unix socket, and
+// the read error that stopped it before the socket was closed.
+func (bt *Statsdbeat) readBatches(conn net.PacketConn, handle func(string, net.Addr)) (bool, error) {
+	udp, ok := conn.(*net.UDPConn)
+	if !ok {
+		return false, nil
+	}
+	// the ipv4 package only adds the control messages, which are not read,
+	// so this works for ipv6 sockets as well
+	pc := ipv4.NewPacketConn(udp)
+	msgs := make([]ipv4.Message, bt.config.ReadBatch)
+	for i := range msgs {
+		// one more byte, like listenAndBuffer
+		msgs[i].Buffers = [][]byte{make([]byte, bt.config.MaxMessageSize+1)}
+	}
+	for {
+		n, err := pc.ReadBatch(msgs, 0)
+		if stoppedReading(err) {
+			return true, nil
+		}
+		if err != nil {
+			if !temporary(err) {
+				return true, readError(conn, err)
+			}
+			logp.Error(err)
+			continue
+		}
+		for _, m := range msgs[:n] {
+			bt.handleDatagram(m.Buffers[0], m.N, m.Addr, handle)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+//go:build linux
+// +build linux
+
+package beater
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+func Test_readBatches(t *testing.T) {
+	c := config.DefaultConfig
+	c.ReadBatch = 4
+	c.MaxMessageSize = 16
+	bt := newStatsdbeat(c)
+
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mux sync.Mutex
+	var received []string
+	done := make(chan struct{})
+	go func() {
+		err := bt.listenAndBuffer(conn, func(msg string, _ net.Addr) {
+			mux.Lock()
+			received = append(received, msg)
+			mux.Unlock()
+		})
+		if err != nil {
+			t.Errorf("listenAndBuffer() error = %v", err)
+		}
+		close(done)
+	}()
+
+	client, err := net.Dial("udp", conn.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	// more datagrams than fit one batch, one longer than max_message_size and
+	// one of exactly max_message_size
+	datagrams := []string{"a:1|c", "b:2|c", "c:3|c", "d:4|c", "e:5|c\nlonger.bucket:1|c", "f:6|c", "g:7|c\nh:888888|g"}
+	for _, d := range datagrams {
+		if _, err = client.Write([]byte(d)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []string{"a:1|c", "b:2|c", "c:3|c", "d:4|c", "e:5|c\n", "f:6|c", "g:7|c\nh:888888|g"}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		mux.Lock()
+		n := len(received)
+		mux.Unlock()
+		if n >= len(want) || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	conn.Close()
+	<-done
+
+	if len(received) != len(want) {
+		t.Fatalf("received %q, want %q", received, want)
+	}
+	for i := range want {
+		if received[i] != want[i] {
+			t.Errorf("datagram %d = %q, want %q", i, received[i], want[i])
+		}
+	}
+	if got := bt.rejected.String(); got != "truncated=1" {
+		t.Errorf("rejected = %v, want truncated=1", got)
+	}
+}
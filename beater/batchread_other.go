@@ -0,0 +1,11 @@
+//go:build !linux
+// +build !linux
+
+package beater
+
+import "net"
+
+// readBatches returns false, recvmmsg is only used on linux.
+func (bt *Statsdbeat) readBatches(conn net.PacketConn, handle func(string, net.Addr)) (bool, error) {
+	return false, nil
+}
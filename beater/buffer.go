@@ -0,0 +1,94 @@
+package beater
+
+import (
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+// eventBuffer keeps the events until the next flush, within the limits of
+// the config. It is not safe for concurrent use.
+type eventBuffer struct {
+	config config.BufferConfig
+	events []beat.Event
+	sizes  []int // the estimated size of the events, when max_bytes is set
+	bytes  int
+	// events dropped because the buffer was full, since start
+	dropped uint64
+	// the most events buffered at once, since start
+	highWater int
+}
+
+func newEventBuffer(c config.BufferConfig) *eventBuffer {
+	return &eventBuffer{config: c}
+}
+
+// full tells whether the buffer has no room for another event of size bytes.
+func (b *eventBuffer) full(size int) bool {
+	if b.config.MaxEvents > 0 && len(b.events) >= b.config.MaxEvents {
+		return true
+	}
+	return b.config.MaxBytes > 0 && len(b.events) > 0 && b.bytes+size > b.config.MaxBytes
+}
+
+// size returns the estimated size of the event, or 0 when the buffer has no
+// max_bytes to check it against.
+func (b *eventBuffer) size(e beat.Event) int {
+	if b.config.MaxBytes == 0 {
+		return 0
+	}
+	return eventSize(e.Fields)
+}
+
+// add buffers the event. When the buffer is full, it drops the oldest events
+// for drop_oldest and the event itself otherwise. The caller waits for room
+// for block. It returns whether the event was kept.
+func (b *eventBuffer) add(e beat.Event, size int) bool {
+	if b.config.Overflow == config.OverflowDropOldest {
+		for b.full(size) && len(b.events) > 0 {
+			b.bytes -= b.sizes[0]
+			b.events[0] = beat.Event{}
+			b.events, b.sizes = b.events[1:], b.sizes[1:]
+			b.dropped++
+		}
+	}
+	if b.full(size) {
+		b.dropped++
+		return false
+	}
+	b.events = append(b.events, e)
+	b.sizes = append(b.sizes, size)
+	b.bytes += size
+	if len(b.events) > b.highWater {
+		b.highWater = len(b.events)
+	}
+	return true
+}
+
+// take returns the buffered events and empties the buffer.
+func (b *eventBuffer) take() []beat.Event {
+	events := b.events
+	b.events, b.sizes, b.bytes = nil, nil, 0
+	return events
+}
+
+// eventSize estimates the encoded size of the fields, the length of the
+// keys and the strings and 8 bytes for any other value.
+func eventSize(fields map[string]interface{}) int {
+	size := 0
+	for k, v := range fields {
+		size += len(k)
+		switch v := v.(type) {
+		case string:
+			size += len(v)
+		case common.MapStr:
+			size += eventSize(v)
+		case map[string]interface{}:
+			size += eventSize(v)
+		default:
+			size += 8
+		}
+	}
+	return size
+}
@@ -0,0 +1,84 @@
+package beater
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+func bufferedBuckets(events []beat.Event) []string {
+	var buckets []string
+	for _, e := range events {
+		buckets = append(buckets, e.Fields["statsd.bucket"].(string))
+	}
+	return buckets
+}
+
+func Test_eventBuffer(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      config.BufferConfig
+		want        []string
+		wantDropped uint64
+	}{
+		{"unlimited", config.BufferConfig{Overflow: config.OverflowDropNewest}, []string{"a", "b", "c", "d"}, 0},
+		{"dropNewest", config.BufferConfig{MaxEvents: 2, Overflow: config.OverflowDropNewest}, []string{"a", "b"}, 2},
+		{"dropOldest", config.BufferConfig{MaxEvents: 2, Overflow: config.OverflowDropOldest}, []string{"c", "d"}, 2},
+		// each event is estimated at 14 bytes, the key and the bucket
+		{"maxBytes", config.BufferConfig{MaxBytes: 30, Overflow: config.OverflowDropOldest}, []string{"c", "d"}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newEventBuffer(tt.config)
+			for _, bucket := range []string{"a", "b", "c", "d"} {
+				e := beat.Event{Fields: common.MapStr{"statsd.bucket": bucket}}
+				b.add(e, b.size(e))
+			}
+			if got := bufferedBuckets(b.take()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("take() = %v, want %v", got, tt.want)
+			}
+			if b.dropped != tt.wantDropped {
+				t.Errorf("dropped = %d, want %d", b.dropped, tt.wantDropped)
+			}
+			if len(b.take()) != 0 || b.bytes != 0 {
+				t.Errorf("take() did not empty the buffer")
+			}
+		})
+	}
+}
+
+func Test_collectBlocksOnFullBuffer(t *testing.T) {
+	c := config.DefaultConfig
+	c.Buffer = config.BufferConfig{MaxEvents: 2, Overflow: config.OverflowBlock}
+	client := &publishedClient{}
+	bt := newStatsdbeat(c)
+	bt.routes[0].client = client
+
+	metrics, _, _ := parseMessage("a:1|g\nb:2|g\nc:3|g", config.DialectInfluxDB)
+	collected := make(chan struct{})
+	go func() {
+		bt.collect(metrics, nil)
+		close(collected)
+	}()
+
+	select {
+	case <-collected:
+		t.Fatal("collect() did not block on the full buffer")
+	case <-time.After(50 * time.Millisecond):
+	}
+	bt.flush(true)
+	<-collected
+	bt.flush(true)
+
+	if got := bufferedBuckets(client.events); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("published %v, want [a b c]", got)
+	}
+	if bt.buffer.dropped != 0 {
+		t.Errorf("dropped = %d, want 0", bt.buffer.dropped)
+	}
+}
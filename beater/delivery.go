@@ -0,0 +1,118 @@
+package beater
+
+import (
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/acker"
+)
+
+// delivery tracks the published events until the outputs acknowledge them,
+// or the pipeline drops them.
+type delivery struct {
+	mux       sync.Mutex
+	published uint64
+	acked     uint64
+	dropped   uint64
+	// the flushes with events that are still pending, oldest first
+	batches []pendingBatch
+}
+
+type pendingBatch struct {
+	events    int
+	published time.Time
+}
+
+// deliveryStats is a snapshot of the delivery, the counts are since start.
+type deliveryStats struct {
+	Published uint64 `json:"published"`
+	Acked     uint64 `json:"acked"`
+	Dropped   uint64 `json:"dropped"`
+	Pending   uint64 `json:"pending"`
+	// how long the oldest pending event waits for its acknowledgement
+	OldestPending time.Duration `json:"-"`
+}
+
+// acker returns the ACK handler of the client, it reports the events in the
+// order they were published.
+func (d *delivery) acker() beat.ACKer {
+	return acker.TrackingCounter(d.onACK)
+}
+
+// client counts the events published with c.
+func (d *delivery) client(c beat.Client) beat.Client {
+	return &deliveryClient{Client: c, delivery: d}
+}
+
+// publish counts the events handed to the client.
+func (d *delivery) publish(events int, now time.Time) {
+	if events == 0 {
+		return
+	}
+	d.mux.Lock()
+	d.published += uint64(events)
+	d.batches = append(d.batches, pendingBatch{events: events, published: now})
+	d.mux.Unlock()
+}
+
+// onACK completes the oldest total events, of which the outputs acknowledged
+// acked and the pipeline dropped the others.
+func (d *delivery) onACK(acked, total int) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.acked += uint64(acked)
+	d.dropped += uint64(total - acked)
+	for total > 0 && len(d.batches) > 0 {
+		b := &d.batches[0]
+		if total < b.events {
+			b.events -= total
+			return
+		}
+		total -= b.events
+		d.batches = d.batches[1:]
+	}
+}
+
+// forget stops waiting for the pending events, the client that published
+// them is closed. It returns how many there were.
+func (d *delivery) forget() uint64 {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	var pending uint64
+	for _, b := range d.batches {
+		pending += uint64(b.events)
+	}
+	d.batches = nil
+	return pending
+}
+
+func (d *delivery) stats(now time.Time) deliveryStats {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	s := deliveryStats{Published: d.published, Acked: d.acked, Dropped: d.dropped}
+	for _, b := range d.batches {
+		s.Pending += uint64(b.events)
+	}
+	if len(d.batches) > 0 {
+		s.OldestPending = now.Sub(d.batches[0].published)
+	}
+	return s
+}
+
+// deliveryClient counts the events before they are published, so their
+// acknowledgement never comes first.
+type deliveryClient struct {
+	beat.Client
+	delivery *delivery
+}
+
+func (c *deliveryClient) Publish(e beat.Event) {
+	c.delivery.publish(1, time.Now())
+	c.Client.Publish(e)
+}
+
+func (c *deliveryClient) PublishAll(events []beat.Event) {
+	c.delivery.publish(len(events), time.Now())
+	c.Client.PublishAll(events)
+}
@@ -0,0 +1,60 @@
+package beater
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+func Test_delivery(t *testing.T) {
+	start := time.Now()
+	tests := []struct {
+		name string
+		// the acknowledgements after flushes of 2, 3 and 4 events
+		acks [][2]int
+		want deliveryStats
+	}{
+		{"nothingAcked", nil, deliveryStats{Published: 9, Pending: 9, OldestPending: 3 * time.Second}},
+		{"firstFlush", [][2]int{{2, 2}}, deliveryStats{Published: 9, Acked: 2, Pending: 7, OldestPending: 2 * time.Second}},
+		{"partOfASecondFlush", [][2]int{{3, 3}}, deliveryStats{Published: 9, Acked: 3, Pending: 6, OldestPending: 2 * time.Second}},
+		{"dropped", [][2]int{{1, 2}, {3, 5}}, deliveryStats{Published: 9, Acked: 4, Dropped: 3, Pending: 2, OldestPending: time.Second}},
+		{"everything", [][2]int{{9, 9}}, deliveryStats{Published: 9, Acked: 9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &delivery{}
+			for i, events := range []int{2, 3, 4} {
+				d.publish(events, start.Add(time.Duration(i)*time.Second))
+			}
+			for _, ack := range tt.acks {
+				d.onACK(ack[0], ack[1])
+			}
+			if got := d.stats(start.Add(3 * time.Second)); got != tt.want {
+				t.Errorf("stats() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_healthStatus(t *testing.T) {
+	bt := newStatsdbeat(config.DefaultConfig)
+	health := NewHealthCheck("127.0.0.1:0", bt.log)
+	health.Status = bt.healthStatus
+	bt.routes[0].delivery.publish(3, time.Now())
+	bt.routes[0].delivery.onACK(2, 2)
+
+	resp := string(health.response())
+	if !strings.HasPrefix(resp, "ok\n") {
+		t.Fatalf("health check responded %q, want ok first", resp)
+	}
+	var status map[string]int64
+	if err := json.Unmarshal([]byte(resp[3:]), &status); err != nil {
+		t.Fatal(err)
+	}
+	if status["published"] != 3 || status["acked"] != 2 || status["pending"] != 1 {
+		t.Errorf("health status = %v, want 3 published, 2 acked and 1 pending", status)
+	}
+}
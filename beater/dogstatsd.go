@@ -0,0 +1,197 @@
+package beater
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+var (
+	eventPriorities = map[string]bool{"normal": true, "low": true}
+	eventAlertTypes = map[string]bool{"info": true, "warning": true, "error": true, "success": true}
+)
+
+// parseDogStatsD parses the DogStatsD lines that are not metrics.
+func parseDogStatsD(msg string) (beat.Event, error) {
+	if strings.HasPrefix(msg, "_sc|") {
+		e, err := parseDogStatsDServiceCheck(msg)
+		if err != nil {
+			return e, &ParseError{Reason: ReasonServiceCheck, Err: err}
+		}
+		return e, nil
+	}
+	e, err := parseDogStatsDEvent(msg)
+	if err != nil {
+		return e, &ParseError{Reason: ReasonEvent, Err: err}
+	}
+	return e, nil
+}
+
+// parseDogStatsDEvent parses a DogStatsD event with format
+//
+//	_e{<title length>,<text length>}:<title>|<text>|d:<timestamp>|h:<hostname>|p:<priority>|t:<alert type>|#<tags>
+//
+// The lengths are in bytes, newlines in the text are escaped as \n. All parts after the text are optional.
+func parseDogStatsDEvent(msg string) (beat.Event, error) {
+	e := beat.Event{
+		Timestamp: time.Now(),
+	}
+
+	end := strings.Index(msg, "}:")
+	if end < 0 {
+		return e, fmt.Errorf("Expecting _e{<title length>,<text length>}: but was %v", msg)
+	}
+	lengths := strings.Split(msg[len("_e{"):end], ",")
+	if len(lengths) != 2 {
+		return e, fmt.Errorf("Expecting 2 lengths in _e{} but was %d", len(lengths))
+	}
+	titleLen, err := strconv.Atoi(lengths[0])
+	if err != nil || titleLen <= 0 {
+		return e, fmt.Errorf("failed to parse the title length %v", lengths[0])
+	}
+	textLen, err := strconv.Atoi(lengths[1])
+	if err != nil || textLen < 0 {
+		return e, fmt.Errorf("failed to parse the text length %v", lengths[1])
+	}
+
+	rest := msg[end+len("}:"):]
+	// compared one by one, the sum of the lengths may overflow
+	if titleLen >= len(rest) || textLen > len(rest)-titleLen-1 || rest[titleLen] != '|' {
+		return e, fmt.Errorf("Expecting a title of %d and a text of %d bytes in %v", titleLen, textLen, rest)
+	}
+	title := rest[:titleLen]
+	text := strings.ReplaceAll(rest[titleLen+1:titleLen+1+textLen], "\\n", "\n")
+	rest = rest[titleLen+1+textLen:]
+
+	fields := common.MapStr{
+		"statsd.type":             "event",
+		"statsd.event.title":      title,
+		"statsd.event.text":       text,
+		"statsd.event.priority":   "normal",
+		"statsd.event.alert_type": "info",
+	}
+	tags := map[string]interface{}{}
+	if len(rest) > 0 {
+		if rest[0] != '|' {
+			return e, fmt.Errorf("Expecting | after the text but was %v", rest)
+		}
+		for _, part := range strings.Split(rest[1:], "|") {
+			switch {
+			case strings.HasPrefix(part, "d:"):
+				ts, err := strconv.ParseInt(part[2:], 10, 64)
+				if err != nil {
+					return e, fmt.Errorf("failed to parse the timestamp %v", part)
+				}
+				e.Timestamp = time.Unix(ts, 0)
+			case strings.HasPrefix(part, "h:"):
+				fields["statsd.event.host"] = part[2:]
+			case strings.HasPrefix(part, "k:"):
+				fields["statsd.event.aggregation_key"] = part[2:]
+			case strings.HasPrefix(part, "s:"):
+				fields["statsd.event.source_type"] = part[2:]
+			case strings.HasPrefix(part, "p:"):
+				if !eventPriorities[part[2:]] {
+					return e, fmt.Errorf("Expecting priority normal or low but was %v", part[2:])
+				}
+				fields["statsd.event.priority"] = part[2:]
+			case strings.HasPrefix(part, "t:"):
+				if !eventAlertTypes[part[2:]] {
+					return e, fmt.Errorf("Expecting alert type info, warning, error or success but was %v", part[2:])
+				}
+				fields["statsd.event.alert_type"] = part[2:]
+			case strings.HasPrefix(part, "#"):
+				addDogStatsDTags(tags, part[1:])
+			default:
+				return e, fmt.Errorf("Unknown event part %v", part)
+			}
+		}
+	}
+	if len(tags) > 0 {
+		fields["statsd.ctx"] = tags
+	}
+
+	e.Fields = fields
+	return e, nil
+}
+
+// serviceCheckStatuses are the DogStatsD service check statuses, by their code.
+var serviceCheckStatuses = []string{"OK", "WARNING", "CRITICAL", "UNKNOWN"}
+
+// parseDogStatsDServiceCheck parses a DogStatsD service check with format
+//
+//	_sc|<name>|<status>|d:<timestamp>|h:<hostname>|#<tags>|m:<message>
+//
+// The status is 0 (OK), 1 (WARNING), 2 (CRITICAL) or 3 (UNKNOWN). The message is always last and may contain |.
+func parseDogStatsDServiceCheck(msg string) (beat.Event, error) {
+	e := beat.Event{
+		Timestamp: time.Now(),
+	}
+
+	var message *string
+	if i := strings.Index(msg, "|m:"); i >= 0 {
+		m := msg[i+len("|m:"):]
+		message = &m
+		msg = msg[:i]
+	}
+
+	parts := strings.Split(msg, "|")
+	if len(parts) < 3 || len(parts[1]) == 0 {
+		return e, fmt.Errorf("Expecting _sc|<name>|<status> but was %v", msg)
+	}
+	code, err := getServiceCheckStatus(parts[2])
+	if err != nil {
+		return e, err
+	}
+
+	fields := common.MapStr{
+		"statsd.type":                      "service_check",
+		"statsd.service_check.name":        parts[1],
+		"statsd.service_check.status":      serviceCheckStatuses[code],
+		"statsd.service_check.status_code": code,
+	}
+	if message != nil {
+		fields["statsd.service_check.message"] = strings.ReplaceAll(*message, "\\n", "\n")
+	}
+	tags := map[string]interface{}{}
+	for _, part := range parts[3:] {
+		switch {
+		case strings.HasPrefix(part, "d:"):
+			ts, err := strconv.ParseInt(part[2:], 10, 64)
+			if err != nil {
+				return e, fmt.Errorf("failed to parse the timestamp %v", part)
+			}
+			e.Timestamp = time.Unix(ts, 0)
+		case strings.HasPrefix(part, "h:"):
+			fields["statsd.service_check.host"] = part[2:]
+		case strings.HasPrefix(part, "#"):
+			addDogStatsDTags(tags, part[1:])
+		default:
+			return e, fmt.Errorf("Unknown service check part %v", part)
+		}
+	}
+	if len(tags) > 0 {
+		fields["statsd.ctx"] = tags
+	}
+
+	e.Fields = fields
+	return e, nil
+}
+
+// getServiceCheckStatus accepts the status code, or its name in any case.
+func getServiceCheckStatus(status string) (int, error) {
+	if code, err := strconv.Atoi(status); err == nil {
+		if code >= 0 && code < len(serviceCheckStatuses) {
+			return code, nil
+		}
+	}
+	for code, name := range serviceCheckStatuses {
+		if strings.EqualFold(status, name) {
+			return code, nil
+		}
+	}
+	return 0, fmt.Errorf("Expecting status 0, 1, 2 or 3 but was %v", status)
+}
@@ -0,0 +1,155 @@
+package beater
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func Test_parseDogStatsDEvent(t *testing.T) {
+	tests := []struct {
+		name          string
+		msg           string
+		want          common.MapStr
+		wantTimestamp time.Time
+		wantErr       bool
+	}{
+		{"minimal",
+			"_e{6,0}:deploy|",
+			common.MapStr{
+				"statsd.type":             "event",
+				"statsd.event.title":      "deploy",
+				"statsd.event.text":       "",
+				"statsd.event.priority":   "normal",
+				"statsd.event.alert_type": "info",
+			},
+			time.Time{},
+			false,
+		},
+		{"full",
+			"_e{9,13}:deploy|v2|line1\\nline 2|d:1600000000|h:ci-01|p:low|t:success|k:deploys|s:jenkins|#env:prod,canary",
+			common.MapStr{
+				"statsd.type":                  "event",
+				"statsd.event.title":           "deploy|v2",
+				"statsd.event.text":            "line1\nline 2",
+				"statsd.event.priority":        "low",
+				"statsd.event.alert_type":      "success",
+				"statsd.event.host":            "ci-01",
+				"statsd.event.aggregation_key": "deploys",
+				"statsd.event.source_type":     "jenkins",
+				"statsd.ctx.env":               "prod",
+				"statsd.ctx.canary":            true,
+			},
+			time.Unix(1600000000, 0),
+			false,
+		},
+		{"utf8", "_e{7,3}:déploy|tëxt", nil, time.Time{}, true},
+		{"titleLengthOverflow", "_e{9223372036854775807,1}:a|b", nil, time.Time{}, true},
+		{"textLengthOverflow", "_e{1,9223372036854775807}:a|b", nil, time.Time{}, true},
+		{"utf8Bytes", "_e{7,5}:déploy|tëxt", common.MapStr{
+			"statsd.type":             "event",
+			"statsd.event.title":      "déploy",
+			"statsd.event.text":       "tëxt",
+			"statsd.event.priority":   "normal",
+			"statsd.event.alert_type": "info",
+		}, time.Time{}, false},
+		{"titleTooLong", "_e{20,4}:deploy|text", nil, time.Time{}, true},
+		{"textTooShort", "_e{6,2}:deploy|text", nil, time.Time{}, true},
+		{"missingLengths", "_e{6}:deploy|text", nil, time.Time{}, true},
+		{"invalidPriority", "_e{6,4}:deploy|text|p:high", nil, time.Time{}, true},
+		{"invalidAlertType", "_e{6,4}:deploy|text|t:fatal", nil, time.Time{}, true},
+		{"invalidTimestamp", "_e{6,4}:deploy|text|d:yesterday", nil, time.Time{}, true},
+		{"unknownPart", "_e{6,4}:deploy|text|x:y", nil, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDogStatsDEvent(tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseDogStatsDEvent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Fields.Flatten(), tt.want) {
+				t.Errorf("parseDogStatsDEvent() = \n%v, want \n%v", got.Fields.Flatten(), tt.want)
+			}
+			if !tt.wantTimestamp.IsZero() && !got.Timestamp.Equal(tt.wantTimestamp) {
+				t.Errorf("parseDogStatsDEvent() timestamp = %v, want %v", got.Timestamp, tt.wantTimestamp)
+			}
+		})
+	}
+}
+
+func Test_parseDogStatsDServiceCheck(t *testing.T) {
+	tests := []struct {
+		name          string
+		msg           string
+		want          common.MapStr
+		wantTimestamp time.Time
+		wantErr       bool
+	}{
+		{"minimal",
+			"_sc|db.up|0",
+			common.MapStr{
+				"statsd.type":                      "service_check",
+				"statsd.service_check.name":        "db.up",
+				"statsd.service_check.status":      "OK",
+				"statsd.service_check.status_code": 0,
+			},
+			time.Time{},
+			false,
+		},
+		{"full",
+			"_sc|db.up|2|d:1600000000|h:db-01|#env:prod,primary|m:connection refused | retrying",
+			common.MapStr{
+				"statsd.type":                      "service_check",
+				"statsd.service_check.name":        "db.up",
+				"statsd.service_check.status":      "CRITICAL",
+				"statsd.service_check.status_code": 2,
+				"statsd.service_check.host":        "db-01",
+				"statsd.service_check.message":     "connection refused | retrying",
+				"statsd.ctx.env":                   "prod",
+				"statsd.ctx.primary":               true,
+			},
+			time.Unix(1600000000, 0),
+			false,
+		},
+		{"statusName",
+			"_sc|db.up|warning",
+			common.MapStr{
+				"statsd.type":                      "service_check",
+				"statsd.service_check.name":        "db.up",
+				"statsd.service_check.status":      "WARNING",
+				"statsd.service_check.status_code": 1,
+			},
+			time.Time{},
+			false,
+		},
+		{"missingStatus", "_sc|db.up", nil, time.Time{}, true},
+		{"missingName", "_sc||0", nil, time.Time{}, true},
+		{"invalidStatus", "_sc|db.up|4", nil, time.Time{}, true},
+		{"invalidTimestamp", "_sc|db.up|0|d:now", nil, time.Time{}, true},
+		{"unknownPart", "_sc|db.up|0|x:y", nil, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDogStatsDServiceCheck(tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseDogStatsDServiceCheck() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Fields.Flatten(), tt.want) {
+				t.Errorf("parseDogStatsDServiceCheck() = \n%v, want \n%v", got.Fields.Flatten(), tt.want)
+			}
+			if !tt.wantTimestamp.IsZero() && !got.Timestamp.Equal(tt.wantTimestamp) {
+				t.Errorf("parseDogStatsDServiceCheck() timestamp = %v, want %v", got.Timestamp, tt.wantTimestamp)
+			}
+		})
+	}
+}
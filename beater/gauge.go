@@ -0,0 +1,70 @@
+package beater
+
+import (
+	"sort"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+)
+
+// gauge is the last known value of a gauge. Unlike the other series it
+// outlives the flush interval, so deltas apply to the previous value.
+type gauge struct {
+	bucket  string
+	tags    map[string]interface{}
+	value   float64
+	updated time.Time
+	route   routeIndex
+}
+
+// addGauge applies the metric to the state of its gauge and replaces the
+// metric value with the resulting value of the gauge. It returns true when
+// the gauge is published each period instead of per metric.
+func (a *aggregator) addGauge(m *metric) bool {
+	key := routeKey(m.route) + seriesKey(m.bucket, m.tags)
+	g, ok := a.gauges[key]
+	if !ok {
+		g = &gauge{bucket: m.bucket, tags: m.tags, route: m.route}
+		a.gauges[key] = g
+	}
+	if m.delta {
+		// +0 and -0 are deltas too, they leave the value as it is
+		g.value += m.value
+	} else {
+		g.value = m.value
+	}
+	g.updated = m.timestamp
+	m.value = g.value
+	return a.config.Gauges.Repeat
+}
+
+// flushGauges forgets the gauges that were idle longer than the idle TTL
+// and, when configured, returns an event with the value of the others.
+func (a *aggregator) flushGauges(now time.Time) []beat.Event {
+	keys := make([]string, 0, len(a.gauges))
+	for k, g := range a.gauges {
+		if a.config.Gauges.IdleTTL > 0 && now.Sub(g.updated) > a.config.Gauges.IdleTTL {
+			delete(a.gauges, k)
+			continue
+		}
+		keys = append(keys, k)
+	}
+	if !a.config.Gauges.Repeat {
+		return nil
+	}
+	sort.Strings(keys)
+
+	events := make([]beat.Event, 0, len(keys))
+	for _, key := range keys {
+		g := a.gauges[key]
+		e := beat.Event{
+			Timestamp: now,
+			Fields:    bucketFields(g.bucket, g.tags),
+			Private:   g.route,
+		}
+		e.Fields.Put("statsd.type", "gauge")
+		e.Fields.Put("statsd.value", g.value)
+		events = append(events, e)
+	}
+	return events
+}
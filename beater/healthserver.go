@@ -1,7 +1,10 @@
 package beater
 
 import (
+	"context"
+	"encoding/json"
 	"net"
+	"time"
 
 	"github.com/elastic/beats/v7/libbeat/logp"
 )
@@ -9,6 +12,8 @@ import (
 type HealthServer struct {
 	addr string
 	log  *logp.Logger
+	// Status returns what is written as JSON after the ok, if set
+	Status func() interface{}
 }
 
 //NewHealthCheck returns a new server that responds to health checks with a status of Http.OK
@@ -20,25 +25,58 @@ func NewHealthCheck(address string, log *logp.Logger) *HealthServer {
 	return s
 }
 
-//Serve sends a small responds to the client that tries to connect.
-func (s *HealthServer) Serve() {
+//Serve sends a small responds to the client that tries to connect, until the context is done.
+//It returns an error only when it cannot listen.
+func (s *HealthServer) Serve(ctx context.Context) error {
 	s.log.Infof("health check listining at %s", s.addr)
 	l, err := net.Listen("tcp", s.addr)
 	if err != nil {
 		s.log.Error("failed to setup health check")
-		return
+		return err
 	}
-	defer l.Close()
+	return s.serve(ctx, l)
+}
+
+//serve responds on the connections of the listener until the context is done.
+func (s *HealthServer) serve(ctx context.Context, l net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
 	for {
 		// Listen for an incoming connection.
 		conn, err := l.Accept()
+		if ctx.Err() != nil {
+			return nil
+		}
 		if err != nil {
-			s.log.Errorf("failed to accept tcp connection. Error %v", err)
-			return
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				s.log.Warnf("failed to accept tcp connection. Error %v", err)
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			// only the health checks stop, statsdbeat keeps running
+			s.log.Errorf("failed to accept tcp connection, stopped the health check. Error %v", err)
+			return nil
 		}
 		s.log.Debug("Response ok on healthcheck")
-		conn.Write([]byte("ok"))
+		conn.Write(s.response())
 		conn.Close()
 	}
 
 }
+
+// response is ok, followed by a line with the status for the checks that
+// read more than the ok.
+func (s *HealthServer) response() []byte {
+	resp := []byte("ok")
+	if s.Status == nil {
+		return resp
+	}
+	status, err := json.Marshal(s.Status())
+	if err != nil {
+		s.log.Errorf("failed to encode the health status. Error %v", err)
+		return resp
+	}
+	return append(append(append(resp, '\n'), status...), '\n')
+}
@@ -0,0 +1,85 @@
+package beater
+
+import (
+	"context"
+	"errors"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// failingListener returns the errors from Accept, then blocks until closed.
+type failingListener struct {
+	net.Listener
+	errs   []error
+	closed chan struct{}
+}
+
+func (l *failingListener) Accept() (net.Conn, error) {
+	if len(l.errs) > 0 {
+		err := l.errs[0]
+		l.errs = l.errs[1:]
+		return nil, err
+	}
+	<-l.closed
+	return nil, net.ErrClosed
+}
+
+func (l *failingListener) Addr() net.Addr {
+	return &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}
+}
+
+func (l *failingListener) Close() error {
+	select {
+	case <-l.closed:
+	default:
+		close(l.closed)
+	}
+	return nil
+}
+
+func Test_healthServerAcceptErrors(t *testing.T) {
+	temporary := &net.OpError{Op: "accept", Net: "tcp", Err: syscall.EMFILE}
+	tests := []struct {
+		name string
+		errs []error
+		// Serve returns without the context being done
+		wantStopped bool
+	}{
+		{"temporary", []error{temporary, temporary}, false},
+		{"permanent", []error{errors.New("broken listener")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewHealthCheck("", logp.NewLogger("test"))
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			served := make(chan error, 1)
+			go func() {
+				served <- s.serve(ctx, &failingListener{errs: tt.errs, closed: make(chan struct{})})
+			}()
+
+			select {
+			case err := <-served:
+				if !tt.wantStopped {
+					t.Fatalf("serve() returned %v after a temporary error", err)
+				}
+				if err != nil {
+					t.Errorf("serve() error = %v, want nil so the beat keeps running", err)
+				}
+				return
+			case <-time.After(100 * time.Millisecond):
+				if tt.wantStopped {
+					t.Fatal("serve() did not stop after a permanent error")
+				}
+			}
+			cancel()
+			if err := <-served; err != nil {
+				t.Errorf("serve() error = %v after the context was done", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package beater
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+// startInput starts listening on the input, with its listeners in the group
+// so their errors stop the beat, and returns the function that stops it
+// again. That function stops accepting connections, reads what arrives
+// until drainUntil, and returns once everything read is collected.
+func (bt *Statsdbeat) startInput(g *errgroup.Group, c config.InputConfig) (func(drainUntil time.Time), error) {
+	handle := bt.inputHandler(c)
+
+	switch c.Protocol {
+	case config.ProtocolUDP:
+		conns, err := listenUDP(c.Address, bt.config.Readers, bt.config.ReceiveBufferSize)
+		if err != nil {
+			return nil, err
+		}
+		return bt.readDatagrams(g, conns, bt.config.Readers, handle), nil
+
+	case config.ProtocolTCP:
+		l, err := net.Listen("tcp", c.Address)
+		if err != nil {
+			return nil, err
+		}
+		s := newStreamServer(l, bt.config.TCP, handle, &bt.rejected, bt.log)
+		g.Go(s.serve)
+		return s.close, nil
+
+	case config.ProtocolUnix:
+		l, err := listenUnix(c)
+		if err != nil {
+			return nil, err
+		}
+		s := newStreamServer(l, bt.config.TCP, handle, &bt.rejected, bt.log)
+		g.Go(s.serve)
+		return func(drainUntil time.Time) {
+			s.close(drainUntil)
+			os.Remove(c.Address)
+		}, nil
+
+	case config.ProtocolUnixgram:
+		conn, err := listenUnixgram(c)
+		if err != nil {
+			return nil, err
+		}
+		closeReaders := bt.readDatagrams(g, []net.PacketConn{conn}, 1, handle)
+		return func(drainUntil time.Time) {
+			closeReaders(drainUntil)
+			os.Remove(c.Address)
+		}, nil
+	}
+	return nil, fmt.Errorf("Unknown input protocol %v", c.Protocol)
+}
+
+// readDatagrams starts the readers of the sockets in the group, which hand
+// the datagrams to a pool of workers. The returned function reads the
+// sockets until drainUntil, closes them and waits until the datagrams read
+// are handled.
+func (bt *Statsdbeat) readDatagrams(g *errgroup.Group, conns []net.PacketConn, readers int, handle func(string, net.Addr)) func(drainUntil time.Time) {
+	pool := newWorkerPool(bt.config.Workers, handle)
+	var wg sync.WaitGroup
+	wg.Add(readers)
+	for i := 0; i < readers; i++ {
+		conn := conns[i%len(conns)]
+		g.Go(func() error {
+			defer wg.Done()
+			return bt.listenAndBuffer(conn, pool.handle)
+		})
+	}
+	return func(drainUntil time.Time) {
+		for _, conn := range conns {
+			conn.SetReadDeadline(drainUntil)
+		}
+		wg.Wait()
+		for _, conn := range conns {
+			conn.Close()
+		}
+		pool.close()
+	}
+}
+
+// inputHandler returns the function that parses the messages of the input
+// in its dialect, and buffers the result with the prefix and the tags of the
+// input for the client of its publish settings.
+func (bt *Statsdbeat) inputHandler(c config.InputConfig) func(string, net.Addr) {
+	publish := bt.config.InputPublish(c)
+	return func(statsdMsg string, addr net.Addr) {
+		bt.metrics.received(statsdMsg)
+		if len(statsdMsg) == 0 {
+			return
+		}
+		bt.log.Debug(fmt.Sprintf("Received %v from %v", statsdMsg, addr))
+
+		metrics, events, errs := parseMessage(statsdMsg, c.Dialect)
+		for _, perr := range errs {
+			bt.rejected.add(perr)
+			bt.log.Error("Failed making a beat", zap.Error(perr), zap.String("line", perr.Text))
+		}
+		if len(errs) == 0 || bt.config.Lenient {
+			atomic.AddUint64(&bt.metrics.lines, uint64(len(metrics)+len(events)))
+			applyInput(c, metrics, events)
+			for i := range metrics {
+				metrics[i].route = bt.bucketRoute(publish, metrics[i].bucket)
+			}
+			for i := range events {
+				events[i].Private = bt.route(publish)
+			}
+			bt.collect(metrics, events)
+		}
+	}
+}
+
+// applyInput prepends the prefix of the input to the buckets, and adds the
+// static tags of the input that the metrics and events do not have already.
+func applyInput(c config.InputConfig, metrics []metric, events []beat.Event) {
+	for i := range metrics {
+		m := &metrics[i]
+		m.bucket = c.Prefix + m.bucket
+		if len(c.Tags) > 0 {
+			m.tags = addStaticTags(m.tags, c.Tags)
+		}
+	}
+	if len(c.Tags) == 0 {
+		return
+	}
+	for i := range events {
+		tags, _ := events[i].Fields["statsd.ctx"].(map[string]interface{})
+		events[i].Fields["statsd.ctx"] = addStaticTags(tags, c.Tags)
+	}
+}
+
+func addStaticTags(tags map[string]interface{}, static map[string]string) map[string]interface{} {
+	if tags == nil {
+		tags = make(map[string]interface{}, len(static))
+	}
+	for k, v := range static {
+		if _, ok := tags[k]; !ok {
+			tags[k] = v
+		}
+	}
+	return tags
+}
@@ -0,0 +1,45 @@
+package beater
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+func Test_applyInput(t *testing.T) {
+	c := config.InputConfig{
+		Dialect: config.DialectDogStatsD,
+		Prefix:  "app.",
+		Tags:    map[string]string{"env": "prod", "dc": "eu"},
+	}
+	metrics, events, errs := parseMessage("requests:1|c|#dc:us\nlatency:3|ms\n_e{2,4}:up|text", c.Dialect)
+	if len(errs) > 0 {
+		t.Fatalf("parseMessage() errs = %v", errs)
+	}
+	applyInput(c, metrics, events)
+
+	want := []struct {
+		bucket string
+		tags   map[string]interface{}
+	}{
+		{"app.requests", map[string]interface{}{"env": "prod", "dc": "us"}},
+		{"app.latency", map[string]interface{}{"env": "prod", "dc": "eu"}},
+	}
+	if len(metrics) != len(want) {
+		t.Fatalf("got %d metrics, want %d", len(metrics), len(want))
+	}
+	for i, w := range want {
+		if metrics[i].bucket != w.bucket {
+			t.Errorf("bucket = %v, want %v", metrics[i].bucket, w.bucket)
+		}
+		if !reflect.DeepEqual(metrics[i].tags, w.tags) {
+			t.Errorf("tags of %v = %v, want %v", w.bucket, metrics[i].tags, w.tags)
+		}
+	}
+
+	wantCtx := map[string]interface{}{"env": "prod", "dc": "eu"}
+	if len(events) != 1 || !reflect.DeepEqual(events[0].Fields["statsd.ctx"], wantCtx) {
+		t.Errorf("events = %v, want statsd.ctx %v", events, wantCtx)
+	}
+}
@@ -0,0 +1,160 @@
+package beater
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+// registry holds the metrics of statsdbeat in the stats of the beat, next to
+// the metrics of the libbeat pipeline.
+var registry = monitoring.Default.NewRegistry("statsdbeat")
+
+// reported is the beat whose metrics are in the registry, there is only one
+// beat per process.
+var reported struct {
+	sync.Mutex
+	bt *Statsdbeat
+}
+
+func init() {
+	monitoring.NewFunc(registry, "input", reportSection(reportInput), monitoring.Report)
+	monitoring.NewFunc(registry, "lines", reportSection(reportLines), monitoring.Report)
+	monitoring.NewFunc(registry, "buffer", reportSection(reportBuffer), monitoring.Report)
+	monitoring.NewFunc(registry, "flush", reportSection(reportFlush), monitoring.Report)
+	monitoring.NewFunc(registry, "delivery", reportSection(reportDelivery), monitoring.Report)
+}
+
+func reportBeat(bt *Statsdbeat) {
+	reported.Lock()
+	reported.bt = bt
+	reported.Unlock()
+}
+
+// reportSection reports a section of the metrics of the reported beat, or
+// nothing when there is none.
+func reportSection(report func(bt *Statsdbeat, V monitoring.Visitor)) func(monitoring.Mode, monitoring.Visitor) {
+	return func(_ monitoring.Mode, V monitoring.Visitor) {
+		reported.Lock()
+		bt := reported.bt
+		reported.Unlock()
+
+		V.OnRegistryStart()
+		defer V.OnRegistryFinished()
+		if bt != nil {
+			report(bt, V)
+		}
+	}
+}
+
+// selfMetrics counts what statsdbeat does, since start.
+type selfMetrics struct {
+	packets  uint64 // the datagrams, and the lines read at once from a stream
+	bytes    uint64
+	lines    uint64 // the lines parsed into metrics and events and collected
+	buffered uint64 // the events added to the buffer, without the ones it dropped
+	flushes  uint64
+	// the duration of the last and the slowest flush
+	flushLast int64
+	flushMax  int64
+}
+
+func (m *selfMetrics) received(msg string) {
+	atomic.AddUint64(&m.packets, 1)
+	atomic.AddUint64(&m.bytes, uint64(len(msg)))
+}
+
+func (m *selfMetrics) flushed(d time.Duration) {
+	atomic.AddUint64(&m.flushes, 1)
+	atomic.StoreInt64(&m.flushLast, int64(d))
+	for {
+		max := atomic.LoadInt64(&m.flushMax)
+		if int64(d) <= max || atomic.CompareAndSwapInt64(&m.flushMax, max, int64(d)) {
+			return
+		}
+	}
+}
+
+func reportInput(bt *Statsdbeat, V monitoring.Visitor) {
+	monitoring.ReportInt(V, "packets", int64(atomic.LoadUint64(&bt.metrics.packets)))
+	monitoring.ReportInt(V, "bytes", int64(atomic.LoadUint64(&bt.metrics.bytes)))
+}
+
+// reportLines reports the parsed lines, and the rejected ones by reason.
+func reportLines(bt *Statsdbeat, V monitoring.Visitor) {
+	monitoring.ReportInt(V, "parsed", int64(atomic.LoadUint64(&bt.metrics.lines)))
+	monitoring.ReportNamespace(V, "rejected", func() {
+		for reason, count := range bt.rejected.counts() {
+			monitoring.ReportInt(V, reason, int64(count))
+		}
+	})
+}
+
+// reportBuffer reports the events buffered now, and their most since start.
+func reportBuffer(bt *Statsdbeat, V monitoring.Visitor) {
+	bt.mux.Lock()
+	events, highWater, dropped := len(bt.buffer.events), bt.buffer.highWater, bt.buffer.dropped
+	bt.mux.Unlock()
+	monitoring.ReportInt(V, "events", int64(events))
+	monitoring.ReportInt(V, "high_water", int64(highWater))
+	monitoring.ReportInt(V, "added", int64(atomic.LoadUint64(&bt.metrics.buffered)))
+	monitoring.ReportInt(V, "dropped", int64(dropped))
+}
+
+func reportFlush(bt *Statsdbeat, V monitoring.Visitor) {
+	monitoring.ReportInt(V, "count", int64(atomic.LoadUint64(&bt.metrics.flushes)))
+	monitoring.ReportInt(V, "last_ms", time.Duration(atomic.LoadInt64(&bt.metrics.flushLast)).Milliseconds())
+	monitoring.ReportInt(V, "max_ms", time.Duration(atomic.LoadInt64(&bt.metrics.flushMax)).Milliseconds())
+}
+
+// reportDelivery reports the delivery of the events since start, and the
+// age of the oldest pending event in milliseconds.
+func reportDelivery(bt *Statsdbeat, V monitoring.Visitor) {
+	s := bt.deliveryStats(time.Now())
+	monitoring.ReportInt(V, "published", int64(s.Published))
+	monitoring.ReportInt(V, "acked", int64(s.Acked))
+	monitoring.ReportInt(V, "dropped", int64(s.Dropped))
+	monitoring.ReportInt(V, "pending", int64(s.Pending))
+	monitoring.ReportInt(V, "oldest_pending_ms", s.OldestPending.Milliseconds())
+}
+
+// selfTotals are the totals of the counters published as self metrics.
+type selfTotals struct {
+	packets  uint64
+	rejected uint64
+	dropped  uint64
+}
+
+// selfEvents returns the events about statsdbeat for the period that ended:
+// the counts since the last period, the events taken from the buffer and how
+// long the last flush took. The caller holds mux.
+func (bt *Statsdbeat) selfEvents(now time.Time, buffered int) []beat.Event {
+	totals := selfTotals{
+		packets: atomic.LoadUint64(&bt.metrics.packets),
+		dropped: bt.buffer.dropped,
+	}
+	for _, n := range bt.rejected.counts() {
+		totals.rejected += n
+	}
+	last := bt.lastSelf
+	bt.lastSelf = totals
+
+	prefix := bt.config.SelfMetrics.Prefix
+	metrics := []metric{
+		{bucket: prefix + "packets_received", _type: "c", value: float64(totals.packets - last.packets)},
+		{bucket: prefix + "bad_lines_seen", _type: "c", value: float64(totals.rejected - last.rejected)},
+		{bucket: prefix + "events_dropped", _type: "c", value: float64(totals.dropped - last.dropped)},
+		{bucket: prefix + "events_buffered", _type: "g", value: float64(buffered)},
+		{bucket: prefix + "processing_time", _type: "g", value: float64(time.Duration(atomic.LoadInt64(&bt.metrics.flushLast)).Milliseconds())},
+	}
+	events := make([]beat.Event, len(metrics))
+	for i := range metrics {
+		metrics[i].timestamp = now
+		metrics[i].sampleRate = 1
+		events[i] = metrics[i].event()
+	}
+	return events
+}
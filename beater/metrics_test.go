@@ -0,0 +1,102 @@
+package beater
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+func Test_registry(t *testing.T) {
+	c := config.DefaultConfig
+	c.Buffer.MaxEvents = 2
+	bt := newStatsdbeat(c)
+	bt.routes[0].client = bt.routes[0].delivery.client(&publishedClient{})
+	reportBeat(bt)
+	defer reportBeat(nil)
+
+	handle := bt.inputHandler(config.InputConfig{Dialect: config.DialectInfluxDB})
+	handle("a:1|g\nb:x|g\nc:1|q", nil)
+	handle("d:1|g\ne:2|g", nil)
+	bt.flush(true)
+	handle("f:1|g", nil)
+
+	got := monitoring.CollectStructSnapshot(registry, monitoring.Full, false)
+	delete(got["flush"].(map[string]interface{}), "last_ms")
+	delete(got["flush"].(map[string]interface{}), "max_ms")
+	want := map[string]interface{}{
+		"input": map[string]interface{}{"packets": int64(3), "bytes": int64(33)},
+		"lines": map[string]interface{}{
+			"parsed":   int64(4),
+			"rejected": map[string]interface{}{ReasonValue: int64(1), ReasonType: int64(1)},
+		},
+		"buffer": map[string]interface{}{"events": int64(1), "high_water": int64(2), "added": int64(3), "dropped": int64(1)},
+		"flush":  map[string]interface{}{"count": int64(1)},
+		"delivery": map[string]interface{}{
+			"published": int64(2), "acked": int64(0), "dropped": int64(0), "pending": int64(2), "oldest_pending_ms": int64(0),
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("registry = %v, want %v", got, want)
+	}
+}
+
+func Test_selfEvents(t *testing.T) {
+	c := config.DefaultConfig
+	c.SelfMetrics = config.SelfConfig{Enabled: true, Prefix: "sb."}
+	c.Buffer.MaxEvents = 2
+	bt := newStatsdbeat(c)
+	client := &publishedClient{}
+	bt.routes[0].client = client
+
+	handle := bt.inputHandler(config.InputConfig{Dialect: config.DialectInfluxDB})
+	handle("a:1|g\nb:x|g", nil)
+	handle("c:1|g\nd:1|g", nil)
+	bt.flush(true)
+	bt.flush(true)
+
+	values := func(events []beat.Event) map[string]interface{} {
+		values := map[string]interface{}{}
+		for _, e := range events {
+			if bucket := e.Fields["statsd.bucket"].(string); strings.HasPrefix(bucket, "sb.") && bucket != "sb.processing_time" {
+				values[bucket], _ = e.Fields.GetValue("statsd.value")
+			}
+		}
+		return values
+	}
+	want := []map[string]interface{}{
+		{"sb.packets_received": int64(2), "sb.bad_lines_seen": int64(1), "sb.events_dropped": int64(1), "sb.events_buffered": float64(2)},
+		{"sb.packets_received": int64(0), "sb.bad_lines_seen": int64(0), "sb.events_dropped": int64(0), "sb.events_buffered": float64(0)},
+	}
+	// a:1, c:1 and the five self events of each period
+	if len(client.events) != 12 {
+		t.Fatalf("published %d events, want 12", len(client.events))
+	}
+	for i, events := range [][]beat.Event{client.events[:7], client.events[7:]} {
+		if got := values(events); !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("period %d self events = %v, want %v", i, got, want[i])
+		}
+	}
+}
+
+func Test_registryCountsCollectedLines(t *testing.T) {
+	c := config.DefaultConfig
+	c.Lenient = false
+	bt := newStatsdbeat(c)
+	reportBeat(bt)
+	defer reportBeat(nil)
+
+	handle := bt.inputHandler(config.InputConfig{Dialect: config.DialectInfluxDB})
+	// the valid line is dropped with the invalid one
+	handle("a:1|g\nb:x|g", nil)
+	handle("c:1|g", nil)
+
+	lines := monitoring.CollectStructSnapshot(registry, monitoring.Full, false)["lines"].(map[string]interface{})
+	if lines["parsed"] != int64(1) {
+		t.Errorf("lines.parsed = %v, want 1", lines["parsed"])
+	}
+}
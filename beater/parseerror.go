@@ -0,0 +1,87 @@
+package beater
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// The reasons a line is rejected.
+const (
+	ReasonFormat       = "format"
+	ReasonType         = "type"
+	ReasonValue        = "value"
+	ReasonSampleRate   = "sample_rate"
+	ReasonEvent        = "event"
+	ReasonServiceCheck = "service_check"
+	ReasonTruncated    = "truncated" // the line did not fit max_message_size
+)
+
+// ParseError is the error for one line of a message that could not be parsed.
+type ParseError struct {
+	Line   int    // index of the line in the message
+	Text   string // the line itself
+	Reason string // one of the Reason constants
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d (%s): %v", e.Line, e.Reason, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// invalid returns a ParseError without the line, parseMessage adds it.
+func invalid(reason string, format string, args ...interface{}) error {
+	return &ParseError{
+		Reason: reason,
+		Err:    fmt.Errorf(format, args...),
+	}
+}
+
+// rejectCounters counts the rejected lines per reason.
+type rejectCounters struct {
+	mux      sync.Mutex
+	byReason map[string]uint64
+}
+
+func (c *rejectCounters) add(err *ParseError) {
+	c.mux.Lock()
+	if c.byReason == nil {
+		c.byReason = map[string]uint64{}
+	}
+	c.byReason[err.Reason]++
+	c.mux.Unlock()
+}
+
+// counts returns a copy of the counts per reason.
+func (c *rejectCounters) counts() map[string]uint64 {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	counts := make(map[string]uint64, len(c.byReason))
+	for r, n := range c.byReason {
+		counts[r] = n
+	}
+	return counts
+}
+
+// String lists the counts as reason=count, sorted by reason.
+func (c *rejectCounters) String() string {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	reasons := make([]string, 0, len(c.byReason))
+	for r := range c.byReason {
+		reasons = append(reasons, r)
+	}
+	sort.Strings(reasons)
+	s := ""
+	for i, r := range reasons {
+		if i > 0 {
+			s += " "
+		}
+		s += fmt.Sprintf("%s=%d", r, c.byReason[r])
+	}
+	return s
+}
@@ -0,0 +1,187 @@
+package beater
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+var publishModes = map[string]beat.PublishMode{
+	config.PublishModeGuaranteed: beat.GuaranteedSend,
+	config.PublishModeDropIfFull: beat.DropIfFull,
+	config.PublishModeDefault:    beat.DefaultGuarantees,
+}
+
+// publishRoute is one pipeline client, for the events with its publish
+// settings.
+type publishRoute struct {
+	publish  config.Publish
+	delivery *delivery
+	client   beat.Client // nil while Run is not connected
+}
+
+// routeIndex is the Private of the buffered events, the index of the route
+// they are published with. Events without one take the first route, the one
+// of the global settings.
+type routeIndex int
+
+// newRoutes returns a route for every publish setting of the inputs and the
+// publish rules, the global settings first.
+func newRoutes(c config.Config) []*publishRoute {
+	publishes := []config.Publish{{Mode: c.PublishMode, WaitClose: c.WaitClose}}
+	for _, in := range c.ListenInputs() {
+		p := c.InputPublish(in)
+		publishes = append(publishes, p)
+		for i := range c.PublishRules {
+			publishes = append(publishes, c.PublishRules[i].Apply(p))
+		}
+	}
+	var routes []*publishRoute
+	seen := map[config.Publish]bool{}
+	for _, p := range publishes {
+		if !seen[p] {
+			seen[p] = true
+			routes = append(routes, &publishRoute{publish: p, delivery: &delivery{}})
+		}
+	}
+	return routes
+}
+
+// route returns the route of the publish settings, or the first one for
+// settings without a route.
+func (bt *Statsdbeat) route(p config.Publish) routeIndex {
+	for i, r := range bt.routes {
+		if r.publish == p {
+			return routeIndex(i)
+		}
+	}
+	return 0
+}
+
+// bucketRoute returns the route of a bucket of an input: the one of the first
+// publish rule matching the bucket, or else the one of the input.
+func (bt *Statsdbeat) bucketRoute(in config.Publish, bucket string) routeIndex {
+	for i := range bt.config.PublishRules {
+		if rule := &bt.config.PublishRules[i]; rule.Matches(bucket) {
+			return bt.route(rule.Apply(in))
+		}
+	}
+	return bt.route(in)
+}
+
+// connect connects a client for every route.
+func (bt *Statsdbeat) connect(pipeline beat.Pipeline) error {
+	for _, r := range bt.routes {
+		r.client = nil
+	}
+	atomic.StoreInt32(&bt.clientsClosed, 0)
+	for _, r := range bt.routes {
+		clientConfig := beat.ClientConfig{
+			PublishMode: publishModes[r.publish.Mode],
+			WaitClose:   r.publish.WaitClose,
+			ACKHandler:  r.delivery.acker(),
+		}
+		if bt.spool != nil {
+			clientConfig = bt.spool.clientConfig(clientConfig)
+		}
+		client, err := pipeline.ConnectWith(clientConfig)
+		if err != nil {
+			bt.closeClients()
+			return err
+		}
+		r.client = r.delivery.client(client)
+	}
+	return nil
+}
+
+// closeClients closes the clients at once, each waits for the
+// acknowledgements up to its wait_close. Closing them again waits until the
+// first close is done.
+func (bt *Statsdbeat) closeClients() {
+	bt.closing.Lock()
+	defer bt.closing.Unlock()
+	if !atomic.CompareAndSwapInt32(&bt.clientsClosed, 0, 1) {
+		return
+	}
+	var wg sync.WaitGroup
+	for _, r := range bt.routes {
+		if r.client == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(r *publishRoute) {
+			defer wg.Done()
+			r.client.Close()
+			if pending := r.delivery.forget(); pending > 0 {
+				bt.log.Warnf("%d published events were not acknowledged within wait_close %v", pending, r.publish.WaitClose)
+			}
+		}(r)
+	}
+	wg.Wait()
+}
+
+// publishAll publishes the events with the clients of their routes.
+func (bt *Statsdbeat) publishAll(events []beat.Event) {
+	if len(bt.routes) == 1 {
+		bt.publishRoute(bt.routes[0], events)
+		return
+	}
+	routed := make([][]beat.Event, len(bt.routes))
+	for _, e := range events {
+		i, _ := e.Private.(routeIndex)
+		routed[i] = append(routed[i], e)
+	}
+	for i, events := range routed {
+		if len(events) > 0 {
+			bt.publishRoute(bt.routes[i], events)
+		}
+	}
+}
+
+func (bt *Statsdbeat) publishRoute(r *publishRoute, events []beat.Event) {
+	if atomic.LoadInt32(&bt.clientsClosed) == 1 {
+		// the shutdown took too long, the clients would not publish them
+		if bt.spool != nil {
+			bt.spool.write(bt.spool.nextID(), events)
+		} else {
+			bt.log.Warnf("Dropped %d events, the clients were closed", len(events))
+		}
+		return
+	}
+	if bt.spool != nil {
+		bt.spool.publishAll(r.client, events)
+	} else {
+		r.client.PublishAll(events)
+	}
+}
+
+// maxWaitClose returns the longest wait_close of the clients.
+func (bt *Statsdbeat) maxWaitClose() time.Duration {
+	var max time.Duration
+	for _, r := range bt.routes {
+		if r.publish.WaitClose > max {
+			max = r.publish.WaitClose
+		}
+	}
+	return max
+}
+
+// deliveryStats adds up the delivery of the routes.
+func (bt *Statsdbeat) deliveryStats(now time.Time) deliveryStats {
+	var s deliveryStats
+	for _, r := range bt.routes {
+		rs := r.delivery.stats(now)
+		s.Published += rs.Published
+		s.Acked += rs.Acked
+		s.Dropped += rs.Dropped
+		s.Pending += rs.Pending
+		if rs.OldestPending > s.OldestPending {
+			s.OldestPending = rs.OldestPending
+		}
+	}
+	return s
+}
@@ -0,0 +1,94 @@
+package beater
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+func Test_publishRoutes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "statsd.sock")
+	noWait := time.Duration(0)
+	c := config.DefaultConfig
+	c.Period = time.Hour
+	c.ShutdownGrace = 50 * time.Millisecond
+	c.Aggregation.Counters = true
+	c.Inputs = []config.InputConfig{{
+		Protocol:    config.ProtocolUnixgram,
+		Address:     path,
+		Dialect:     config.DialectInfluxDB,
+		PublishMode: config.PublishModeDropIfFull,
+		WaitClose:   &noWait,
+	}}
+	c.PublishRules = []config.PublishRule{{Buckets: []string{"orders.*"}, PublishMode: config.PublishModeGuaranteed}}
+	bt := newStatsdbeat(c)
+	pipeline := &fakePipeline{}
+	ran, conn := startRun(t, bt, pipeline, path)
+
+	fmt.Fprint(conn, "orders.created:1|c\nrequests:1|c\nlatency:3|ms\norders.total:9|g")
+	conn.Close()
+	bt.Stop()
+	if err := <-ran; err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	// the global settings, the input and its rule
+	want := []struct {
+		mode      beat.PublishMode
+		waitClose time.Duration
+		buckets   []string
+	}{
+		{beat.GuaranteedSend, 10 * time.Second, nil},
+		{beat.DropIfFull, 0, []string{"latency", "requests"}},
+		{beat.GuaranteedSend, 0, []string{"orders.created", "orders.total"}},
+	}
+	if len(pipeline.clients) != len(want) {
+		t.Fatalf("connected %d clients, want %d", len(pipeline.clients), len(want))
+	}
+	for i, w := range want {
+		cc := pipeline.configs[i]
+		if cc.PublishMode != w.mode || cc.WaitClose != w.waitClose {
+			t.Errorf("client %d publish mode %v and wait_close %v, want %v and %v", i, cc.PublishMode, cc.WaitClose, w.mode, w.waitClose)
+		}
+		got := bufferedBuckets(pipeline.clients[i].events)
+		sort.Strings(got)
+		if !pipeline.clients[i].closed || !reflect.DeepEqual(got, w.buckets) {
+			t.Errorf("client %d published %v, closed %v, want %v and closed", i, got, pipeline.clients[i].closed, w.buckets)
+		}
+	}
+}
+
+func Test_aggregatorKeepsRoutesApart(t *testing.T) {
+	c := config.DefaultConfig
+	c.Aggregation.Counters = true
+	c.Gauges.Repeat = true
+	a := newAggregator(c)
+	now := time.Now()
+	for _, route := range []routeIndex{1, 2, 1} {
+		for _, typ := range []string{"c", "g"} {
+			m := metric{timestamp: now, bucket: "orders", _type: typ, value: 1, delta: true, sampleRate: 1, route: route}
+			a.add(&m)
+		}
+	}
+
+	got := map[string]interface{}{}
+	for _, e := range a.flush(now.Add(time.Second)) {
+		typ, _ := e.Fields.GetValue("statsd.type")
+		value, _ := e.Fields.GetValue("statsd.value")
+		got[fmt.Sprintf("%v/%v", typ, e.Private)] = value
+	}
+	want := map[string]interface{}{
+		"counter/1": int64(2), "counter/2": int64(1),
+		"gauge/1": float64(2), "gauge/2": float64(1),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("aggregator.flush() = %v, want %v", got, want)
+	}
+}
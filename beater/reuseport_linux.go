@@ -0,0 +1,30 @@
+//go:build linux
+// +build linux
+
+package beater
+
+import (
+	"net"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// reusePort tells whether several udp sockets can bind the same port, the
+// kernel then balances the datagrams over the sockets.
+const reusePort = true
+
+// reusePortConfig listens with SO_REUSEPORT set on the socket.
+func reusePortConfig() net.ListenConfig {
+	return net.ListenConfig{
+		Control: func(network, address string, c syscall.RawConn) error {
+			var err error
+			if cerr := c.Control(func(fd uintptr) {
+				err = unix.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_REUSEPORT, 1)
+			}); cerr != nil {
+				return cerr
+			}
+			return err
+		},
+	}
+}
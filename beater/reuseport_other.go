@@ -0,0 +1,14 @@
+//go:build !linux
+// +build !linux
+
+package beater
+
+import "net"
+
+// reusePort tells whether several udp sockets can bind the same port. Not
+// on this platform, the readers share one socket.
+const reusePort = false
+
+func reusePortConfig() net.ListenConfig {
+	return net.ListenConfig{}
+}
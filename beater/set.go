@@ -0,0 +1,73 @@
+package beater
+
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+// set keeps the distinct members of one bucket and tag set during a period.
+// Beyond config.SetConfig.MaxMembers the members are either no longer
+// counted (exact mode) or estimated with a hyperLogLog.
+type set struct {
+	config    config.SetConfig
+	members   map[string]struct{}
+	hll       *hyperLogLog
+	truncated bool
+}
+
+func newSet(c config.SetConfig) *set {
+	return &set{
+		config:  c,
+		members: map[string]struct{}{},
+	}
+}
+
+func (s *set) add(m *metric) {
+	if s.hll != nil {
+		s.hll.add(m.member)
+		return
+	}
+	if _, ok := s.members[m.member]; ok {
+		return
+	}
+	if len(s.members) < s.config.MaxMembers {
+		s.members[m.member] = struct{}{}
+		return
+	}
+
+	if s.config.Mode == config.SetModeHyperLogLog {
+		s.hll = newHyperLogLog()
+		for member := range s.members {
+			s.hll.add(member)
+		}
+		s.hll.add(m.member)
+		s.members = nil
+		return
+	}
+	s.truncated = true
+}
+
+// count returns the number of distinct members.
+func (s *set) count() int64 {
+	if s.hll != nil {
+		return s.hll.count()
+	}
+	return int64(len(s.members))
+}
+
+func (s *set) fields(time.Duration) common.MapStr {
+	f := common.MapStr{
+		"statsd.type":  "set",
+		"statsd.value": s.count(),
+	}
+	if s.hll != nil {
+		f["statsd.approximate"] = true
+	}
+	if s.truncated {
+		f["statsd.truncated"] = true
+	}
+	return f
+}
@@ -0,0 +1,318 @@
+package beater
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/acker"
+	"github.com/elastic/beats/v7/libbeat/logp"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+const (
+	spoolExt        = ".spool"
+	spoolCorruptExt = ".corrupt"
+	// spoolHeader starts every spool file, followed by the crc32 of the events
+	spoolHeader = "statsdbeat-spool-v1"
+)
+
+// spool keeps the published batches until they are acknowledged. The events
+// the pipeline dropped and, on close, the events that were not acknowledged
+// are written to the spool directory, and published again after the next
+// start. Delivery is at least once: a batch may be published twice when the
+// beat stops before its acknowledgement.
+type spool struct {
+	dir      string
+	maxBytes int64
+	log      *logp.Logger
+
+	mux     sync.Mutex
+	seq     uint64
+	pending map[*spoolBatch]struct{}
+	// the events dropped during the current publish
+	dropped []beat.Event
+	closed  bool
+}
+
+// spoolBatch is one flush of events, or the events of one spool file.
+type spoolBatch struct {
+	id     string
+	file   string // the spool file a replayed batch was read from
+	events []beat.Event
+	acked  int
+	// the events written to the spool already, by index
+	spooled map[int]bool
+}
+
+// spoolRef is the Private of a published event.
+type spoolRef struct {
+	batch *spoolBatch
+	index int
+}
+
+// spoolEvent is how an event is written to a spool file.
+type spoolEvent struct {
+	Timestamp time.Time     `json:"timestamp"`
+	Meta      common.MapStr `json:"meta,omitempty"`
+	Fields    common.MapStr `json:"fields"`
+}
+
+func newSpool(dir string, c config.SpoolConfig, log *logp.Logger) (*spool, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("Failed to create the spool directory %v: %v", dir, err)
+	}
+	return &spool{
+		dir:      dir,
+		maxBytes: c.MaxBytes,
+		log:      log,
+		pending:  map[*spoolBatch]struct{}{},
+	}, nil
+}
+
+// clientConfig adds the acknowledgement and the drop callbacks of the spool.
+func (s *spool) clientConfig(c beat.ClientConfig) beat.ClientConfig {
+	if c.ACKHandler != nil {
+		c.ACKHandler = acker.Combine(c.ACKHandler, acker.EventPrivateReporter(s.onACK))
+	} else {
+		c.ACKHandler = acker.EventPrivateReporter(s.onACK)
+	}
+	c.Events = s
+	return c
+}
+
+// replay publishes the batches of the spool files, oldest first, until the
+// context is done. Corrupt files are renamed and skipped.
+func (s *spool) replay(ctx context.Context, client beat.Client) error {
+	files, err := filepath.Glob(filepath.Join(s.dir, "*"+spoolExt))
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+	for i, file := range files {
+		if ctx.Err() != nil {
+			s.log.Infof("Stopped replaying the spool, %d files are left for the next start", len(files)-i)
+			return nil
+		}
+		events, err := readSpoolFile(file)
+		if err != nil {
+			s.log.Errorf("Skipped the corrupt spool file %v: %v", file, err)
+			os.Rename(file, strings.TrimSuffix(file, spoolExt)+spoolCorruptExt)
+			continue
+		}
+		s.log.Infof("Publishing %d events of the spool file %v", len(events), file)
+		id := strings.TrimSuffix(filepath.Base(file), spoolExt)
+		s.publish(client, &spoolBatch{id: id, file: file, events: events})
+	}
+	return nil
+}
+
+// publishAll publishes the events as a new batch.
+func (s *spool) publishAll(client beat.Client, events []beat.Event) {
+	s.publish(client, &spoolBatch{id: s.nextID(), events: events})
+}
+
+func (s *spool) publish(client beat.Client, b *spoolBatch) {
+	for i := range b.events {
+		b.events[i].Private = spoolRef{batch: b, index: i}
+	}
+	s.mux.Lock()
+	s.pending[b] = struct{}{}
+	s.mux.Unlock()
+
+	client.PublishAll(b.events)
+
+	s.mux.Lock()
+	dropped := s.dropped
+	s.dropped = nil
+	s.completed(b)
+	s.mux.Unlock()
+	if len(dropped) > 0 {
+		s.log.Warnf("The pipeline dropped %d events, writing them to the spool", len(dropped))
+		s.write(s.nextID(), dropped)
+	}
+}
+
+func (s *spool) nextID() string {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.seq++
+	return fmt.Sprintf("%020d-%06d", time.Now().UnixNano(), s.seq)
+}
+
+// onACK counts the acknowledged events of their batches. The events are
+// acknowledged in the order they were published.
+func (s *spool) onACK(_ int, data []interface{}) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.closed {
+		return
+	}
+	for _, d := range data {
+		ref, ok := d.(spoolRef)
+		if !ok {
+			continue
+		}
+		ref.batch.acked++
+		s.completed(ref.batch)
+	}
+}
+
+// completed forgets the batch once all its events are acknowledged or
+// written to the spool. The caller holds mux.
+func (s *spool) completed(b *spoolBatch) {
+	if b.acked+len(b.spooled) < len(b.events) {
+		return
+	}
+	delete(s.pending, b)
+	if len(b.file) > 0 {
+		os.Remove(b.file)
+	}
+}
+
+// close writes the events that are not acknowledged to the spool. The
+// client is closed already.
+func (s *spool) close() {
+	s.mux.Lock()
+	s.closed = true
+	pending := s.pending
+	s.pending = nil
+	s.mux.Unlock()
+
+	batches := make([]*spoolBatch, 0, len(pending))
+	for b := range pending {
+		batches = append(batches, b)
+	}
+	sort.Slice(batches, func(i, j int) bool { return batches[i].id < batches[j].id })
+	for _, b := range batches {
+		if len(b.file) > 0 && b.acked == 0 {
+			// the spool file is still there
+			continue
+		}
+		var events []beat.Event
+		for i := b.acked; i < len(b.events); i++ {
+			if !b.spooled[i] {
+				events = append(events, b.events[i])
+			}
+		}
+		if len(events) > 0 {
+			// a replayed batch replaces its spool file
+			s.log.Infof("Writing %d events that were not acknowledged to the spool", len(events))
+			s.write(b.id, events)
+		} else if len(b.file) > 0 {
+			os.Remove(b.file)
+		}
+	}
+}
+
+// write stores the events in a new spool file, unless that exceeds
+// max_bytes.
+func (s *spool) write(id string, events []beat.Event) {
+	data, err := encodeSpoolFile(events)
+	if err != nil {
+		s.log.Errorf("Failed to encode %d events for the spool: %v", len(events), err)
+		return
+	}
+	if size := s.size(); size+int64(len(data)) > s.maxBytes {
+		s.log.Errorf("Dropped %d events, the spool of %d bytes would exceed max_bytes %d", len(events), size, s.maxBytes)
+		return
+	}
+	file := filepath.Join(s.dir, id+spoolExt)
+	tmp := file + ".tmp"
+	if err = ioutil.WriteFile(tmp, data, 0640); err == nil {
+		err = os.Rename(tmp, file)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		s.log.Errorf("Failed to write %d events to the spool: %v", len(events), err)
+	}
+}
+
+// size returns the bytes of the spool files.
+func (s *spool) size() int64 {
+	files, _ := filepath.Glob(filepath.Join(s.dir, "*"+spoolExt))
+	var size int64
+	for _, file := range files {
+		if fi, err := os.Stat(file); err == nil {
+			size += fi.Size()
+		}
+	}
+	return size
+}
+
+func encodeSpoolFile(events []beat.Event) ([]byte, error) {
+	se := make([]spoolEvent, len(events))
+	for i, e := range events {
+		se[i] = spoolEvent{Timestamp: e.Timestamp, Meta: e.Meta, Fields: e.Fields}
+	}
+	payload, err := json.Marshal(se)
+	if err != nil {
+		return nil, err
+	}
+	header := fmt.Sprintf("%s %08x\n", spoolHeader, crc32.ChecksumIEEE(payload))
+	return append([]byte(header), payload...), nil
+}
+
+func readSpoolFile(file string) ([]beat.Event, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	nl := bytes.IndexByte(data, '\n')
+	if nl < 0 {
+		return nil, fmt.Errorf("no header")
+	}
+	var checksum uint32
+	if _, err = fmt.Sscanf(string(data[:nl]), spoolHeader+" %08x", &checksum); err != nil {
+		return nil, fmt.Errorf("invalid header: %v", err)
+	}
+	payload := data[nl+1:]
+	if crc := crc32.ChecksumIEEE(payload); crc != checksum {
+		return nil, fmt.Errorf("checksum %08x does not match %08x", crc, checksum)
+	}
+	var se []spoolEvent
+	if err = json.Unmarshal(payload, &se); err != nil {
+		return nil, err
+	}
+	events := make([]beat.Event, len(se))
+	for i, e := range se {
+		events[i] = beat.Event{Timestamp: e.Timestamp, Meta: e.Meta, Fields: e.Fields}
+	}
+	return events, nil
+}
+
+// The beat.ClientEventer callbacks, the spool only needs the drops.
+
+func (s *spool) Closing()               {}
+func (s *spool) Closed()                {}
+func (s *spool) Published()             {}
+func (s *spool) FilteredOut(beat.Event) {}
+
+// DroppedOnPublish collects the event for the spool file written after the
+// publish.
+func (s *spool) DroppedOnPublish(e beat.Event) {
+	ref, ok := e.Private.(spoolRef)
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	// the events of a replayed batch are still in its spool file
+	if !ok || s.closed || len(ref.batch.file) > 0 {
+		return
+	}
+	if ref.batch.spooled == nil {
+		ref.batch.spooled = map[int]bool{}
+	}
+	ref.batch.spooled[ref.index] = true
+	s.dropped = append(s.dropped, e)
+}
@@ -0,0 +1,149 @@
+package beater
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+func spoolEvents(buckets ...string) []beat.Event {
+	var events []beat.Event
+	for _, bucket := range buckets {
+		events = append(events, beat.Event{
+			Timestamp: time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC),
+			Fields:    common.MapStr{"statsd.bucket": bucket, "statsd.value": 1.5},
+		})
+	}
+	return events
+}
+
+func spoolFiles(t *testing.T, dir string, pattern string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, pattern))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func Test_spoolReplaysUnacknowledged(t *testing.T) {
+	dir := t.TempDir()
+	s, err := newSpool(dir, config.DefaultConfig.Spool, logp.NewLogger("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &publishedClient{}
+	s.publishAll(client, spoolEvents("a", "b", "c"))
+	s.publishAll(client, spoolEvents("d"))
+	// the first event is acknowledged before the client is closed
+	s.onACK(1, []interface{}{client.events[0].Private})
+	client.Close()
+	s.close()
+
+	if files := spoolFiles(t, dir, "*"+spoolExt); len(files) != 2 {
+		t.Fatalf("spool files = %v, want 2", files)
+	}
+
+	s, err = newSpool(dir, config.DefaultConfig.Spool, logp.NewLogger("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = &publishedClient{}
+	if err = s.replay(context.Background(), client); err != nil {
+		t.Fatalf("replay() error = %v", err)
+	}
+	if got := bufferedBuckets(client.events); !reflect.DeepEqual(got, []string{"b", "c", "d"}) {
+		t.Errorf("replayed %v, want [b c d]", got)
+	}
+	if got := client.events[0]; !got.Timestamp.Equal(spoolEvents("b")[0].Timestamp) || got.Fields["statsd.value"] != 1.5 {
+		t.Errorf("replayed event = %v", got)
+	}
+
+	var privates []interface{}
+	for _, e := range client.events {
+		privates = append(privates, e.Private)
+	}
+	s.onACK(len(privates), privates)
+	if files := spoolFiles(t, dir, "*"); len(files) != 0 {
+		t.Errorf("spool files after the acknowledgement = %v, want none", files)
+	}
+}
+
+func Test_spoolSkipsCorruptFiles(t *testing.T) {
+	dir := t.TempDir()
+	s, err := newSpool(dir, config.DefaultConfig.Spool, logp.NewLogger("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.write("1", spoolEvents("a"))
+	s.write("2", spoolEvents("b"))
+	file := filepath.Join(dir, "1"+spoolExt)
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[len(data)-3] ^= 0xff
+	if err = ioutil.WriteFile(file, data, 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	client := &publishedClient{}
+	if err = s.replay(context.Background(), client); err != nil {
+		t.Fatalf("replay() error = %v", err)
+	}
+	if got := bufferedBuckets(client.events); !reflect.DeepEqual(got, []string{"b"}) {
+		t.Errorf("replayed %v, want [b]", got)
+	}
+	if _, err = os.Stat(filepath.Join(dir, "1"+spoolCorruptExt)); err != nil {
+		t.Errorf("the corrupt file was not renamed: %v", err)
+	}
+}
+
+// droppingClient drops every event, like a full queue with DropIfFull.
+type droppingClient struct {
+	publishedClient
+	events beat.ClientEventer
+}
+
+func (c *droppingClient) PublishAll(events []beat.Event) {
+	for _, e := range events {
+		c.events.DroppedOnPublish(e)
+	}
+}
+
+func Test_spoolDroppedAndMaxBytes(t *testing.T) {
+	dir := t.TempDir()
+	c := config.DefaultConfig.Spool
+	s, err := newSpool(dir, c, logp.NewLogger("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &droppingClient{events: s}
+	s.publishAll(client, spoolEvents("a", "b"))
+	if files := spoolFiles(t, dir, "*"+spoolExt); len(files) != 1 {
+		t.Fatalf("spool files = %v, want the dropped events", files)
+	}
+	if len(s.pending) != 0 {
+		t.Errorf("the spooled batch is still pending")
+	}
+	// written already, not again on close
+	s.close()
+	if files := spoolFiles(t, dir, "*"+spoolExt); len(files) != 1 {
+		t.Errorf("spool files after close = %v, want 1", files)
+	}
+
+	s.maxBytes = s.size() + 10
+	s.write("full", spoolEvents("c"))
+	if files := spoolFiles(t, dir, "*"+spoolExt); len(files) != 1 {
+		t.Errorf("spool files = %v, the spool exceeds max_bytes", files)
+	}
+}
@@ -1,35 +1,57 @@
 package beater
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"net"
+	"os"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/paths"
 
 	"github.com/sentient/statsdbeat/config"
 )
 
 // Statsdbeat configuration.
 type Statsdbeat struct {
-	done   chan struct{}
 	config config.Config
-	client beat.Client
+	routes []*publishRoute // the pipeline clients, by their publish settings
 	//
-	stopping bool
-	stopped  bool
-	address  *net.UDPAddr
 	pipeline beat.Pipeline // Interface to publish event.
-	buffer   []beat.Event
+	buffer   *eventBuffer
+	agg      *aggregator // guarded by mux, like buffer
 	mux      sync.Mutex
+	space    *sync.Cond // signaled when the buffer was emptied, for the block overflow
+	flushNow chan struct{}
+	ageTimer *time.Timer // requests a flush when the oldest buffered event reaches max_event_age
 	log      *logp.Logger
 	health   *HealthServer
+	spool    *spool // nil unless the spool is enabled
+	rejected rejectCounters
+	metrics  *selfMetrics
+	// the rejected counts at the last flush, to log them only when they change
+	lastRejected string
+	lastDropped  uint64
+	// the totals at the last self metrics, guarded by mux
+	lastSelf selfTotals
+
+	lifecycle sync.Mutex
+	cancel    context.CancelFunc // stops the running Run, guarded by lifecycle
+	// the clients are closed, 1 once closeClients started
+	clientsClosed int32
+	closing       sync.Mutex
+	// Stop was called before Run, guarded by lifecycle
+	stopRequested bool
 }
 
 // New creates an instance of statsdbeat.
@@ -40,24 +62,14 @@ func New(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
 		return nil, fmt.Errorf("Error reading config file: %v", err)
 	}
 
-	bt := &Statsdbeat{
-		done:   make(chan struct{}),
-		config: c,
-		log:    logp.NewLogger("statsdbeat"),
-	}
-
-	bt.address, err = net.ResolveUDPAddr("udp", c.UDPAddress)
-	if err != nil {
-		bt.log.Errorf("Failed to resolve udp address: %v, %v", c.UDPAddress, err)
-		return nil, err
-	}
-	bt.log.Infof("Statsd server listening for UDP packages at '%v'", c.UDPAddress)
-
+	bt := newStatsdbeat(c)
 	bt.pipeline = b.Publisher
+	reportBeat(bt)
 
 	if len(c.TCPHealthAddress) > 0 {
 		bt.log.Infof("Setup serving health checks at '%v'", c.TCPHealthAddress)
 		bt.health = NewHealthCheck(c.TCPHealthAddress, bt.log)
+		bt.health.Status = bt.healthStatus
 	} else {
 		bt.log.Info("No TCP health check configured. E.g. you could set statsdbeat.healthserver: \":8080\" to respond to TCP health checks")
 	}
@@ -65,94 +77,358 @@ func New(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
 	return bt, nil
 }
 
-func (bt *Statsdbeat) listenAndBuffer(conn *net.UDPConn) {
-	buf := make([]byte, 1024)
-	for {
-		n, addr, err := conn.ReadFromUDP(buf)
-		if bt.stopping || bt.stopped {
-			return
+func newStatsdbeat(c config.Config) *Statsdbeat {
+	bt := &Statsdbeat{
+		config:   c,
+		buffer:   newEventBuffer(c.Buffer),
+		agg:      newAggregator(c),
+		routes:   newRoutes(c),
+		metrics:  &selfMetrics{},
+		flushNow: make(chan struct{}, 1),
+		log:      logp.NewLogger("statsdbeat"),
+	}
+	bt.space = sync.NewCond(&bt.mux)
+	return bt
+}
+
+// listenAndBuffer reads the datagrams of the socket until it is closed or
+// the drain ends. It returns the read error that stopped it before.
+func (bt *Statsdbeat) listenAndBuffer(conn net.PacketConn, handle func(string, net.Addr)) error {
+	if bt.config.ReadBatch > 1 {
+		if batched, err := bt.readBatches(conn, handle); batched {
+			return err
 		}
-		statsdMsg := string(buf[0:n])
-		if len(statsdMsg) > 0 {
-			bt.log.Debug(fmt.Sprintf("Received %v from %v", statsdMsg, addr))
-
-			events, err := ParseBeats(statsdMsg)
-			if err != nil {
-				bt.log.Error("Failed making a beat", zap.Error(err))
-			} else {
-				bt.mux.Lock()
-				bt.buffer = append(bt.buffer, events...)
-				bt.mux.Unlock()
-			}
+	}
+	// one more byte tells a datagram of max_message_size from a longer one
+	buf := make([]byte, bt.config.MaxMessageSize+1)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if stoppedReading(err) {
+			return nil
 		}
+		bt.handleDatagram(buf, n, addr, handle)
 
 		if err != nil {
+			if !temporary(err) {
+				return readError(conn, err)
+			}
 			logp.Error(err)
 		}
 	}
 }
 
-// Run starts statsdbeat.
+// stoppedReading tells whether the read error is the end of the drain on
+// shutdown, or the socket was closed.
+func stoppedReading(err error) bool {
+	return errors.Is(err, os.ErrDeadlineExceeded) || errors.Is(err, net.ErrClosed)
+}
+
+// temporary tells whether reading can go on after the error.
+func temporary(err error) bool {
+	ne, ok := err.(net.Error)
+	return ok && ne.Temporary()
+}
+
+// readError is the error of a reader that stopped the beat.
+func readError(conn net.PacketConn, err error) error {
+	return fmt.Errorf("Failed to read from %v: %v", conn.LocalAddr(), err)
+}
+
+// handleDatagram hands the n bytes read into buf to handle. The buffer has
+// one byte more than max_message_size, when the datagram is longer than
+// that its last line is dropped.
+func (bt *Statsdbeat) handleDatagram(buf []byte, n int, addr net.Addr, handle func(string, net.Addr)) {
+	msg := buf[0:n]
+	if n > bt.config.MaxMessageSize {
+		msg, _ = trimPartialLine(msg)
+		bt.rejected.add(&ParseError{Reason: ReasonTruncated})
+		bt.log.Warnf("Dropped the last line of a message from %v that did not fit max_message_size %d", addr, bt.config.MaxMessageSize)
+	}
+	handle(string(msg), addr)
+}
+
+// trimPartialLine drops everything after the last newline of a message that
+// filled the read buffer, that part was cut off by the read.
+func trimPartialLine(msg []byte) ([]byte, bool) {
+	if len(msg) == 0 || msg[len(msg)-1] == '\n' {
+		return msg, false
+	}
+	return msg[:bytes.LastIndexByte(msg, '\n')+1], true
+}
+
+// Run starts statsdbeat. It returns after Stop, once the inputs are drained
+// and their events are published, and can run again after that.
 func (bt *Statsdbeat) Run(b *beat.Beat) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if !bt.started(cancel) {
+		return nil
+	}
+	defer bt.finished()
 	bt.log.Info("statsdbeat is running! Hit CTRL-C to stop it.")
 
 	var err error
-	bt.client, err = b.Publisher.ConnectWith(beat.ClientConfig{
-		PublishMode: beat.GuaranteedSend,
-		WaitClose:   10 * time.Second,
+	if bt.config.Spool.Enabled {
+		if bt.spool, err = newSpool(paths.Resolve(paths.Data, bt.config.Spool.Path), bt.config.Spool, bt.log); err != nil {
+			return err
+		}
+	}
+	if err = bt.connect(b.Publisher); err != nil {
+		return err
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	// the health checks are answered during the replay already
+	if bt.health != nil {
+		g.Go(func() error {
+			return bt.health.Serve(ctx)
+		})
+	}
+	g.Go(func() error {
+		return bt.serve(ctx, g)
 	})
+	return g.Wait()
+}
 
-	if err != nil {
-		return err
+// serve replays the spool, listens on the inputs with their listeners in
+// the group, and flushes until the context is done. When the publishing blocks longer than shutdown_grace
+// and wait_close after that, the clients are closed, which also stops a
+// blocked replay.
+func (bt *Statsdbeat) serve(ctx context.Context, g *errgroup.Group) error {
+	flushed := make(chan struct{})
+	go bt.closeClientsAfter(ctx, flushed, bt.config.ShutdownGrace+bt.maxWaitClose())
+
+	// the events of the last run go first, with the global settings
+	if bt.spool != nil {
+		if err := bt.spool.replay(ctx, bt.routes[0].client); err != nil {
+			close(flushed)
+			bt.closeClient()
+			return fmt.Errorf("Failed to replay the spool: %v", err)
+		}
 	}
 
 	// I was able to connect to ElasticSearch
-	// ready to receive UDP packages...
-	conn, err := net.ListenUDP("udp", bt.address)
-	if err != nil {
-		return err
+	// ready to receive statsd messages...
+	var closers []func(time.Time)
+	for _, input := range bt.config.ListenInputs() {
+		if ctx.Err() != nil {
+			// stopped during the replay
+			break
+		}
+		closeInput, err := bt.startInput(g, input)
+		if err != nil {
+			for _, closeInput := range closers {
+				closeInput(time.Now())
+			}
+			close(flushed)
+			bt.closeClient()
+			return fmt.Errorf("Failed to listen on %v input '%v': %v", input.Protocol, input.Address, err)
+		}
+		bt.log.Infof("Statsd server listening on %v '%v' for the %v dialect", input.Protocol, input.Address, input.Dialect)
+		closers = append(closers, closeInput)
 	}
-	defer func() {
-		if conn != nil {
-			conn.Close()
+
+	drained := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		var inputs sync.WaitGroup
+		inputs.Add(len(closers))
+		for _, closeInput := range closers {
+			go func(closeInput func(time.Time)) {
+				defer inputs.Done()
+				closeInput(time.Now().Add(bt.config.ShutdownGrace))
+			}(closeInput)
 		}
+		inputs.Wait()
+		close(drained)
 	}()
+	bt.flushUntilDrained(ctx, drained, flushed)
+	return nil
+}
 
-	go bt.listenAndBuffer(conn)
-
-	if bt.health != nil {
-		go bt.health.Serve()
+// started registers the cancel func of a Run, unless Stop was called before.
+func (bt *Statsdbeat) started(cancel context.CancelFunc) bool {
+	bt.lifecycle.Lock()
+	defer bt.lifecycle.Unlock()
+	if bt.stopRequested {
+		bt.stopRequested = false
+		return false
 	}
+	bt.cancel = cancel
+	return true
+}
+
+func (bt *Statsdbeat) finished() {
+	bt.lifecycle.Lock()
+	bt.cancel = nil
+	bt.lifecycle.Unlock()
+}
 
+// flushUntilDrained flushes each period and on request until the context is
+// done. Then it keeps flushing on request while the inputs drain for
+// shutdown_grace, publishes what they collected with a last flush and closes
+// the client. It closes flushed after the last flush.
+func (bt *Statsdbeat) flushUntilDrained(ctx context.Context, drained <-chan struct{}, flushed chan<- struct{}) {
 	ticker := time.NewTicker(bt.config.Period)
+	defer ticker.Stop()
 
-	for {
+	for running := true; running; {
 		select {
-		case <-bt.done:
-			if conn != nil {
-				bt.stopped = true
-				bt.log.Info("stop listening on UDP")
-				conn.Close()
-			}
-			return nil
+		case <-ctx.Done():
+			running = false
 		case <-ticker.C:
-			bt.sendStatsdBuffer()
+			bt.flush(true)
+		case <-bt.flushNow:
+			bt.flush(false)
+		}
+	}
+
+	bt.log.Infof("Stop listening on the inputs, draining them for %v", bt.config.ShutdownGrace)
+	// keep flushing for flush_size, max_event_age and the block overflow
+	for waiting := true; waiting; {
+		select {
+		case <-drained:
+			waiting = false
+		case <-bt.flushNow:
+			bt.flush(false)
+		}
+	}
+
+	bt.flush(true)
+	close(flushed)
+	bt.log.Info("Waiting for the published events to be acknowledged")
+	bt.closeClient()
+}
+
+// closeClientsAfter closes the clients when the last flush is not done the
+// timeout after the context.
+func (bt *Statsdbeat) closeClientsAfter(ctx context.Context, flushed <-chan struct{}, timeout time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-flushed:
+		return
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		bt.log.Warnf("Publishing did not finish within shutdown_grace and wait_close %v, closing the clients", timeout)
+		bt.closeClients()
+	case <-flushed:
+	}
+}
+
+// closeClient closes the clients, which wait for the acknowledgements up to
+// wait_close, and spools the events that are still not acknowledged.
+func (bt *Statsdbeat) closeClient() {
+	bt.closeClients()
+	if bt.spool != nil {
+		bt.spool.close()
+	}
+}
+
+// healthStatus reports the delivery of the events to the health checks.
+func (bt *Statsdbeat) healthStatus() interface{} {
+	s := bt.deliveryStats(time.Now())
+	return struct {
+		deliveryStats
+		OldestPendingMs int64 `json:"oldest_pending_ms"`
+	}{s, s.OldestPending.Milliseconds()}
+}
+
+// collect buffers the events and the metrics as events, unless they are
+// aggregated until the next flush.
+func (bt *Statsdbeat) collect(metrics []metric, events []beat.Event) {
+	bt.mux.Lock()
+	for _, e := range events {
+		bt.bufferEvent(e)
+	}
+	for i := range metrics {
+		if !bt.agg.add(&metrics[i]) {
+			e := metrics[i].event()
+			e.Private = metrics[i].route
+			bt.bufferEvent(e)
+		}
+	}
+	bt.mux.Unlock()
+}
+
+// bufferEvent adds the event to the buffer, for the block overflow after
+// waiting for the next flush when it is full. The caller holds mux.
+func (bt *Statsdbeat) bufferEvent(e beat.Event) {
+	size := bt.buffer.size(e)
+	if bt.config.Buffer.Overflow == config.OverflowBlock {
+		for bt.buffer.full(size) {
+			bt.requestFlush()
+			bt.space.Wait()
 		}
 	}
+	if bt.buffer.add(e, size) {
+		atomic.AddUint64(&bt.metrics.buffered, 1)
+	}
+
+	if bt.config.FlushSize > 0 && len(bt.buffer.events) >= bt.config.FlushSize {
+		bt.requestFlush()
+	}
+	if bt.config.MaxEventAge > 0 && bt.ageTimer == nil {
+		bt.ageTimer = time.AfterFunc(bt.config.MaxEventAge, bt.requestFlush)
+	}
 }
 
-func (bt *Statsdbeat) sendStatsdBuffer() {
+// requestFlush makes Run flush the buffered events, unless a flush is
+// requested already. Run flushes one at a time, so they never overlap.
+func (bt *Statsdbeat) requestFlush() {
+	select {
+	case bt.flushNow <- struct{}{}:
+	default:
+	}
+}
+
+// flush publishes the buffered events, and each period the aggregated
+// metrics as well. The flushes requested for flush_size and max_event_age
+// leave the aggregates to the period they are calculated for.
+func (bt *Statsdbeat) flush(periodic bool) {
+	start := time.Now()
+	defer func() { bt.metrics.flushed(time.Since(start)) }()
+
 	bt.mux.Lock()
-	if len(bt.buffer) > 0 {
-		bt.log.Info("Sending buffer " + strconv.Itoa(len(bt.buffer)))
-		bt.client.PublishAll(bt.buffer)
-		bt.buffer = nil
+	events := bt.buffer.take()
+	if periodic {
+		buffered := len(events)
+		events = append(events, bt.agg.flush(time.Now())...)
+		if bt.config.SelfMetrics.Enabled {
+			events = append(events, bt.selfEvents(time.Now(), buffered)...)
+		}
+	}
+	if bt.ageTimer != nil {
+		bt.ageTimer.Stop()
+		bt.ageTimer = nil
+	}
+	bt.space.Broadcast()
+	if rejected := bt.rejected.String(); rejected != bt.lastRejected {
+		bt.log.Warnf("Rejected lines since start: %s", rejected)
+		bt.lastRejected = rejected
+	}
+	if dropped := bt.buffer.dropped; dropped != bt.lastDropped {
+		bt.log.Warnf("Dropped %d events since start because the buffer was full, overflow %v", dropped, bt.config.Buffer.Overflow)
+		bt.lastDropped = dropped
 	}
 	bt.mux.Unlock()
+
+	// publishing may block, the inputs keep buffering meanwhile
+	if len(events) > 0 {
+		bt.log.Info("Sending buffer " + strconv.Itoa(len(events)))
+		bt.publishAll(events)
+	}
 }
 
-// Stop stops statsdbeat.
+// Stop stops statsdbeat. Run publishes what was received before it returns.
 func (bt *Statsdbeat) Stop() {
-	bt.client.Close()
-	close(bt.done)
+	bt.lifecycle.Lock()
+	defer bt.lifecycle.Unlock()
+	if bt.cancel != nil {
+		bt.cancel()
+	} else {
+		bt.stopRequested = true
+	}
 }
@@ -0,0 +1,411 @@
+package beater
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/logp"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+func Test_trimPartialLine(t *testing.T) {
+	tests := []struct {
+		name          string
+		msg           string
+		want          string
+		wantTruncated bool
+	}{
+		{"complete", "a:1|c\nb:2|c\n", "a:1|c\nb:2|c\n", false},
+		{"partial", "a:1|c\nb:2|c\nc:3", "a:1|c\nb:2|c\n", true},
+		{"singlePartial", "a:12345", "", true},
+		{"empty", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, truncated := trimPartialLine([]byte(tt.msg))
+			if string(got) != tt.want || truncated != tt.wantTruncated {
+				t.Errorf("trimPartialLine() = %q, %v, want %q, %v", got, truncated, tt.want, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+func Test_handleDatagram(t *testing.T) {
+	tests := []struct {
+		name          string
+		datagram      string
+		want          string
+		wantTruncated string
+	}{
+		{"shorter", "a:1|c\nb:2|g", "a:1|c\nb:2|g", ""},
+		{"exactlyMaxMessageSize", "a:1|c\nb:22|g", "a:1|c\nb:22|g", ""},
+		{"longer", "a:1|c\nb:222|g", "a:1|c\n", "truncated=1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := config.DefaultConfig
+			c.MaxMessageSize = 12
+			bt := newStatsdbeat(c)
+			buf := make([]byte, c.MaxMessageSize+1)
+			// the read stops at the end of the buffer
+			n := copy(buf, tt.datagram)
+
+			var got string
+			bt.handleDatagram(buf, n, nil, func(msg string, _ net.Addr) { got = msg })
+			if got != tt.want {
+				t.Errorf("handleDatagram() handled %q, want %q", got, tt.want)
+			}
+			if rejected := bt.rejected.String(); rejected != tt.wantTruncated {
+				t.Errorf("rejected = %q, want %q", rejected, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+func Test_flushSize(t *testing.T) {
+	c := config.DefaultConfig
+	c.FlushSize = 2
+	c.Aggregation.Counters = true
+	bt := newStatsdbeat(c)
+	client := &publishedClient{}
+	bt.routes[0].client = client
+
+	metrics, _, _ := parseMessage("a:1|g\nb:1|c", config.DialectInfluxDB)
+	bt.collect(metrics, nil)
+	select {
+	case <-bt.flushNow:
+		t.Fatal("flush requested before flush_size was reached")
+	default:
+	}
+	metrics, _, _ = parseMessage("c:1|g", config.DialectInfluxDB)
+	bt.collect(metrics, nil)
+	select {
+	case <-bt.flushNow:
+	default:
+		t.Fatal("no flush requested when flush_size was reached")
+	}
+
+	// the aggregated counter waits for the period
+	bt.flush(false)
+	if got := bufferedBuckets(client.events); !reflect.DeepEqual(got, []string{"a", "c"}) {
+		t.Errorf("published %v, want [a c]", got)
+	}
+}
+
+func Test_maxEventAge(t *testing.T) {
+	c := config.DefaultConfig
+	c.MaxEventAge = 20 * time.Millisecond
+	bt := newStatsdbeat(c)
+	bt.routes[0].client = &publishedClient{}
+
+	metrics, _, _ := parseMessage("a:1|g", config.DialectInfluxDB)
+	start := time.Now()
+	bt.collect(metrics, nil)
+	select {
+	case <-bt.flushNow:
+		if waited := time.Since(start); waited < c.MaxEventAge {
+			t.Errorf("flush requested after %v, want at least %v", waited, c.MaxEventAge)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no flush requested for an event older than max_event_age")
+	}
+	bt.flush(false)
+	if bt.ageTimer != nil {
+		t.Error("flush() did not stop the max_event_age timer")
+	}
+}
+
+// publishedClient keeps the published events.
+type publishedClient struct {
+	mux    sync.Mutex
+	events []beat.Event
+	closed bool
+	// events published after Close
+	late int
+	// unless nil, publishing blocks until Close like a full output
+	blocked chan struct{}
+}
+
+func (c *publishedClient) Publish(e beat.Event) {
+	c.PublishAll([]beat.Event{e})
+}
+
+func (c *publishedClient) PublishAll(events []beat.Event) {
+	if c.blocked != nil {
+		<-c.blocked
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if c.closed {
+		c.late += len(events)
+		return
+	}
+	c.events = append(c.events, events...)
+}
+
+func (c *publishedClient) Close() error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if !c.closed && c.blocked != nil {
+		close(c.blocked)
+	}
+	c.closed = true
+	return nil
+}
+
+// fakePipeline hands out a new publishedClient for every connection.
+type fakePipeline struct {
+	mux     sync.Mutex
+	clients []*publishedClient
+	configs []beat.ClientConfig // of the clients
+	// the clients block until they are closed
+	block bool
+}
+
+func (p *fakePipeline) Connect() (beat.Client, error) {
+	return p.ConnectWith(beat.ClientConfig{})
+}
+
+func (p *fakePipeline) ConnectWith(c beat.ClientConfig) (beat.Client, error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	client := &publishedClient{}
+	if p.block {
+		client.blocked = make(chan struct{})
+	}
+	p.clients = append(p.clients, client)
+	p.configs = append(p.configs, c)
+	return client, nil
+}
+
+// startRun runs the beat and returns the result of Run, and a connection
+// to its unixgram input once that accepts datagrams.
+func startRun(t *testing.T, bt *Statsdbeat, pipeline beat.Pipeline, path string) (<-chan error, net.Conn) {
+	ran := make(chan error, 1)
+	go func() {
+		ran <- bt.Run(&beat.Beat{Publisher: pipeline})
+	}()
+
+	var conn net.Conn
+	var err error
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		if conn, err = net.Dial("unixgram", path); err == nil {
+			return ran, conn
+		}
+	}
+	t.Fatalf("the input did not start: %v", err)
+	return nil, nil
+}
+
+func Test_stopPublishesEverything(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "statsd.sock")
+	c := config.DefaultConfig
+	c.Period = time.Hour
+	c.ShutdownGrace = 200 * time.Millisecond
+	c.Aggregation.Counters = true
+	c.Inputs = []config.InputConfig{{Protocol: config.ProtocolUnixgram, Address: path, Dialect: config.DialectInfluxDB}}
+	bt := newStatsdbeat(c)
+	pipeline := &fakePipeline{}
+	ran, conn := startRun(t, bt, pipeline, path)
+
+	const sent = 500
+	for i := 0; i < sent; i++ {
+		fmt.Fprintf(conn, "gauge%d:%d|g\nrequests:1|c", i, i)
+	}
+	conn.Close()
+
+	bt.Stop()
+	if err := <-ran; err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	client := pipeline.clients[0]
+	if !client.closed {
+		t.Error("Run() did not close the client")
+	}
+	if client.late > 0 {
+		t.Errorf("%d events were published after the client was closed", client.late)
+	}
+	gauges, requests := 0, interface{}(nil)
+	for _, e := range client.events {
+		if e.Fields["statsd.bucket"] == "requests" {
+			requests = e.Fields["statsd.value"]
+		} else {
+			gauges++
+		}
+	}
+	if gauges != sent || requests != int64(sent) {
+		t.Errorf("published %d gauges and a count of %v requests, want %d of both", gauges, requests, sent)
+	}
+}
+
+func Test_stopWithABlockedOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "statsd.sock")
+	spoolDir := t.TempDir()
+	c := config.DefaultConfig
+	c.Period = time.Hour
+	c.ShutdownGrace = 50 * time.Millisecond
+	c.WaitClose = 100 * time.Millisecond
+	c.Spool.Enabled = true
+	c.Spool.Path = spoolDir
+	c.Inputs = []config.InputConfig{{Protocol: config.ProtocolUnixgram, Address: path, Dialect: config.DialectInfluxDB}}
+	bt := newStatsdbeat(c)
+	pipeline := &fakePipeline{block: true}
+	ran, conn := startRun(t, bt, pipeline, path)
+
+	fmt.Fprint(conn, "a:1|g\nb:2|g")
+	conn.Close()
+	bt.Stop()
+	select {
+	case err := <-ran:
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return while the output blocked")
+	}
+
+	// the events the blocked output never took are spooled
+	var spooled []string
+	for _, file := range spoolFiles(t, spoolDir, "*"+spoolExt) {
+		events, err := readSpoolFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		spooled = append(spooled, bufferedBuckets(events)...)
+	}
+	if !reflect.DeepEqual(spooled, []string{"a", "b"}) {
+		t.Errorf("spooled %v, want [a b]", spooled)
+	}
+}
+
+func Test_stopDuringABlockedReplay(t *testing.T) {
+	spoolDir := t.TempDir()
+	c := config.DefaultConfig
+	c.ShutdownGrace = 50 * time.Millisecond
+	c.WaitClose = 100 * time.Millisecond
+	c.Spool.Enabled = true
+	c.Spool.Path = spoolDir
+	c.Inputs = []config.InputConfig{{Protocol: config.ProtocolUnixgram, Address: filepath.Join(t.TempDir(), "statsd.sock")}}
+	s, err := newSpool(spoolDir, c.Spool, logp.NewLogger("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.write(s.nextID(), spoolEvents("a"))
+	s.write(s.nextID(), spoolEvents("b"))
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	healthAddress := l.Addr().String()
+	l.Close()
+	bt := newStatsdbeat(c)
+	bt.health = NewHealthCheck(healthAddress, bt.log)
+	ran := make(chan error, 1)
+	go func() {
+		ran <- bt.Run(&beat.Beat{Publisher: &fakePipeline{block: true}})
+	}()
+
+	// the health checks are answered while the replay blocks
+	var conn net.Conn
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		if conn, err = net.Dial("tcp", healthAddress); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		t.Fatalf("the health server did not start: %v", err)
+	}
+	if resp, err := ioutil.ReadAll(conn); err != nil || !strings.HasPrefix(string(resp), "ok") {
+		t.Errorf("health check response = %q, %v, want ok", resp, err)
+	}
+	conn.Close()
+
+	bt.Stop()
+	select {
+	case err := <-ran:
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return while the replay blocked")
+	}
+	if files := spoolFiles(t, spoolDir, "*"+spoolExt); len(files) != 2 {
+		t.Errorf("spool files = %v, want both left for the next start", files)
+	}
+}
+
+func Test_runStopRepeatedly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "statsd.sock")
+	c := config.DefaultConfig
+	c.ShutdownGrace = 50 * time.Millisecond
+	c.Inputs = []config.InputConfig{{Protocol: config.ProtocolUnixgram, Address: path, Dialect: config.DialectInfluxDB}}
+	bt := newStatsdbeat(c)
+	pipeline := &fakePipeline{}
+
+	for i := 0; i < 3; i++ {
+		ran, conn := startRun(t, bt, pipeline, path)
+		fmt.Fprintf(conn, "run%d:1|g", i)
+		conn.Close()
+		bt.Stop()
+		if err := <-ran; err != nil {
+			t.Fatalf("Run() %d error = %v", i, err)
+		}
+	}
+
+	if len(pipeline.clients) != 3 {
+		t.Fatalf("connected %d clients, want 3", len(pipeline.clients))
+	}
+	for i, client := range pipeline.clients {
+		want := []string{fmt.Sprintf("run%d", i)}
+		if got := bufferedBuckets(client.events); !client.closed || !reflect.DeepEqual(got, want) {
+			t.Errorf("client %d published %v, closed %v, want %v and closed", i, got, client.closed, want)
+		}
+	}
+}
+
+func Test_stopBeforeRun(t *testing.T) {
+	c := config.DefaultConfig
+	c.UDPAddress = ""
+	bt := newStatsdbeat(c)
+	bt.Stop()
+
+	ran := make(chan error, 1)
+	go func() {
+		ran <- bt.Run(&beat.Beat{Publisher: &fakePipeline{}})
+	}()
+	select {
+	case err := <-ran:
+		if err != nil {
+			t.Errorf("Run() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return after an earlier Stop")
+	}
+}
+
+func Test_runFailsWithTheHealthServer(t *testing.T) {
+	c := config.DefaultConfig
+	c.UDPAddress = "127.0.0.1:0"
+	c.ShutdownGrace = 0
+	bt := newStatsdbeat(c)
+	bt.health = NewHealthCheck("127.0.0.1:-1", bt.log)
+	pipeline := &fakePipeline{}
+
+	if err := bt.Run(&beat.Beat{Publisher: pipeline}); err == nil {
+		t.Error("Run() without an error, want the error of the health server")
+	}
+	if len(pipeline.clients) != 1 || !pipeline.clients[0].closed {
+		t.Error("Run() did not close the client")
+	}
+}
@@ -1,53 +1,203 @@
 package beater
 
 import (
-	"errors"
-	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
+
+	"github.com/sentient/statsdbeat/config"
 )
 
 /*ParseBeats takes a string constructs a  beat.Event.
-  the msg has format <bucket>(,<k>=<v>)*:<value>|<type>|@<sample rate>
+  the msg has format <bucket>(,<k>=<v>)*:<value>|<type>|@<sample rate>|#<k>:<v>,<tag>
+  or is a DogStatsD event _e{<title length>,<text length>}:<title>|<text>|...
+  or a DogStatsD service check _sc|<name>|<status>|...
+  Set members are counted per message, as if the message was one flush interval.
 */
 func ParseBeats(msg string) ([]beat.Event, error) {
+	metrics, result, errs := parseMessage(msg, config.DialectInfluxDB)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	now := time.Now()
+	sets := newAggregator(config.DefaultConfig)
+	for i := range metrics {
+		if !sets.add(&metrics[i]) {
+			result = append(result, metrics[i].event())
+		}
+	}
+	return append(result, sets.flush(now)...), nil
+}
+
+// parseMessage parses every non empty line of msg. The events are lines that
+// are published as is and never aggregated, like DogStatsD events. Invalid
+// lines are skipped and reported as a *ParseError each. The dialect is one of
+// the config.Dialect constants.
+func parseMessage(msg string, dialect string) ([]metric, []beat.Event, []*ParseError) {
 	parts := strings.Split(msg, "\n")
-	result := []beat.Event{}
+	metrics := []metric{}
+	events := []beat.Event{}
+	var errs []*ParseError
 	for p := range parts {
 		if len(strings.TrimSpace(parts[p])) == 0 {
 			//skip empty lines
 			continue
 		}
-		b, err := parseBeat(parts[p])
+		if strings.HasPrefix(parts[p], "_e{") || strings.HasPrefix(parts[p], "_sc|") {
+			e, err := parseDogStatsD(parts[p])
+			if err != nil {
+				errs = append(errs, lineError(p, parts[p], err))
+				continue
+			}
+			events = append(events, e)
+			continue
+		}
+		m, err := parseMetric(parts[p], dialect)
 		if err != nil {
-			return nil, err
+			errs = append(errs, lineError(p, parts[p], err))
+			continue
 		}
-		result = append(result, b...)
+		metrics = append(metrics, m)
 	}
-	return result, nil
+	return metrics, events, errs
 }
 
-func parseBeat(msg string) ([]beat.Event, error) {
+// lineError adds the line to the ParseError.
+func lineError(line int, text string, err error) *ParseError {
+	pe, ok := err.(*ParseError)
+	if !ok {
+		pe = &ParseError{Reason: ReasonFormat, Err: err}
+	}
+	pe.Line = line
+	pe.Text = text
+	return pe
+}
+
+// metric is one parsed statsd line.
+type metric struct {
+	timestamp  time.Time
+	bucket     string
+	tags       map[string]interface{}
+	_type      string
+	value      float64
+	member     string // only for sets
+	sampleRate float64
+	sampled    bool       // the line had a @<sample rate> part
+	delta      bool       // a gauge value with a sign, to add to the current value
+	route      routeIndex // the client that publishes the metric, set by the input
+}
+
+func parseMetric(msg string, dialect string) (metric, error) {
+	m := metric{
+		timestamp:  time.Now(),
+		sampleRate: 1,
+	}
 	parts := strings.Split(msg, "|")
-	if len(parts) < 2 || len(parts) > 3 {
-		return nil, fmt.Errorf("Expecting 2 or 3 parts of | but was %d", len(parts))
+	if len(parts) < 2 {
+		return m, invalid(ReasonFormat, "Expecting at least 2 parts of | but was %d", len(parts))
+	}
+
+	m._type = strings.TrimSpace(parts[1])
+	if _, ok := metricTypes[m._type]; !ok {
+		return m, invalid(ReasonType, "Type %v not handled yet", m._type)
 	}
 
 	//parts[0] has structure of  <bucket>(,<k>=<v>)*:<value>
-	bucket, tags, val, err := getBucketTagsValue(parts[0])
+	var err error
+	if m._type == "s" {
+		m.bucket, m.tags, m.member, err = getBucketTagsMember(parts[0], dialect)
+	} else {
+		m.bucket, m.tags, m.value, err = getBucketTagsValue(parts[0], dialect)
+	}
 	if err != nil {
-		return nil, err
+		return m, err
+	}
+	if m._type == "g" {
+		value := parts[0][strings.Index(parts[0], ":")+1:]
+		m.delta = strings.HasPrefix(value, "+") || strings.HasPrefix(value, "-")
 	}
 
-	_type := strings.TrimSpace(parts[1])
-	e := &beat.Event{
-		Timestamp: time.Now(),
+	//the optional parts are @<sample rate> and the DogStatsD #<tags>, in any order,
+	//and the DogStatsD extensions c:<container id> and T<unix timestamp>
+	tagged := false
+	for _, part := range parts[2:] {
+		part = strings.TrimSpace(part)
+		switch {
+		case strings.HasPrefix(part, "@") && !m.sampled:
+			if m.sampleRate, err = getSampleRate(part); err != nil {
+				return m, err
+			}
+			m.sampled = true
+		case strings.HasPrefix(part, "#") && !tagged:
+			addDogStatsDTags(m.tags, part[1:])
+			tagged = true
+		case strings.HasPrefix(part, "c:"):
+			// the container of the client, not used
+		case strings.HasPrefix(part, "T"):
+			seconds, err := strconv.ParseInt(part[1:], 10, 64)
+			if err != nil || seconds <= 0 {
+				return m, invalid(ReasonFormat, "Expecting T<unix timestamp> but was %v", part)
+			}
+			m.timestamp = time.Unix(seconds, 0)
+		default:
+			return m, invalid(ReasonFormat, "Expecting @<sample rate> or #<tags> once but was %v", part)
+		}
+	}
+
+	return m, nil
+}
+
+// addDogStatsDTags adds the DogStatsD tags key:value,key2 to tags. A tag
+// without value is added as true.
+func addDogStatsDTags(tags map[string]interface{}, part string) {
+	for _, tag := range strings.Split(part, ",") {
+		tag = strings.TrimSpace(tag)
+		if len(tag) == 0 {
+			continue
+		}
+		if kv := strings.SplitN(tag, ":", 2); len(kv) == 2 {
+			tags[kv[0]] = kv[1]
+		} else {
+			tags[tag] = true
+		}
+	}
+}
+
+// metricTypes maps the statsd type to the statsd.type of the event.
+var metricTypes = map[string]string{
+	"c":  "counter",
+	"g":  "gauge",
+	"h":  "histogram",
+	"ms": "timing",
+	"s":  "set",
+}
+
+// event converts the metric into a raw beat.Event.
+func (m *metric) event() beat.Event {
+	e := beat.Event{
+		Timestamp: m.timestamp,
+		Fields:    bucketFields(m.bucket, m.tags),
+	}
+	e.Fields.Put("statsd.type", metricTypes[m._type])
+	if m.sampled {
+		e.Fields.Put("statsd.sample_rate", m.sampleRate)
 	}
 
+	switch m._type {
+	case "c":
+		e.Fields.Put("statsd.value", counterValue(m.value*(1/m.sampleRate)))
+	default:
+		e.Fields.Put("statsd.value", m.value)
+	}
+	return e
+}
+
+// bucketFields returns the statsd fields that identify the bucket.
+func bucketFields(bucket string, tags map[string]interface{}) common.MapStr {
 	ns, sect, tgt, act := splitBucket(bucket)
 	bucketMap := common.MapStr{
 		"statsd.bucket": bucket,
@@ -65,52 +215,55 @@ func parseBeat(msg string) ([]beat.Event, error) {
 	if len(tags) > 0 {
 		bucketMap.Put("statsd.ctx", tags)
 	}
+	return bucketMap
+}
 
-	switch _type {
-	case "c":
-		{
-			e.Fields = common.MapStr{
-				"statsd.value": val,
-				"statsd.type":  "counter",
-			}
-		}
-	case "g":
-		{
-			e.Fields = common.MapStr{
-				"statsd.value": val,
-				"statsd.type":  "gauge",
-			}
-		}
-	case "h":
-		{
-			e.Fields = common.MapStr{
-				"statsd.value": val,
-				"statsd.type":  "histogram",
-			}
-		}
-	case "ms":
-		{
-			e.Fields = common.MapStr{
-				"statsd.value": val,
-				"statsd.type":  "timing",
-			}
-		}
+func getBucketTagsValue(part string, dialect string) (bucket string, tags map[string]interface{}, val float64, err error) {
+	var raw string
+	if bucket, tags, raw, err = splitBucketTagsValue(part, dialect); err != nil {
+		return bucket, tags, 0, err
+	}
 
-	default:
-		{
-			return nil, fmt.Errorf("Type %v not handled yet", _type)
-		}
+	if val, err = strconv.ParseFloat(raw, 64); err != nil {
+		return bucket, tags, 0, invalid(ReasonValue, "failed to parse the value to a number %v", raw)
+	}
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return bucket, tags, 0, invalid(ReasonValue, "value is not a finite number %v", raw)
 	}
 
-	e.Fields.Update(bucketMap)
+	return bucket, tags, val, nil
+}
+
+// getBucketTagsMember is getBucketTagsValue for sets, where the value is the
+// identifier of the member instead of a number.
+func getBucketTagsMember(part string, dialect string) (bucket string, tags map[string]interface{}, member string, err error) {
+	if bucket, tags, member, err = splitBucketTagsValue(part, dialect); err != nil {
+		return bucket, tags, "", err
+	}
+	if len(member) == 0 {
+		return bucket, tags, "", invalid(ReasonValue, "missing the set member for %v", bucket)
+	}
+	return bucket, tags, member, nil
+}
 
-	return []beat.Event{*e}, nil
+// bucketTagSeparators separate the bucket and its tags, per dialect. The
+// dogstatsd dialect has no tags in the bucket.
+var bucketTagSeparators = map[string]string{
+	config.DialectInfluxDB: ",",
+	config.DialectGraphite: ";",
 }
 
-func getBucketTagsValue(part string) (bucket string, tags map[string]interface{}, val int, err error) {
+func splitBucketTagsValue(part string, dialect string) (bucket string, tags map[string]interface{}, value string, err error) {
 
-	parts := strings.Split(part, ":")
-	subParts := strings.Split(parts[0], ",")
+	parts := strings.SplitN(part, ":", 2)
+	if len(parts) != 2 {
+		return "", nil, "", invalid(ReasonFormat, "Expecting <bucket>:<value> but was %v", part)
+	}
+	sep, ok := bucketTagSeparators[dialect]
+	if !ok {
+		return parts[0], map[string]interface{}{}, parts[1], nil
+	}
+	subParts := strings.Split(parts[0], sep)
 	bucket = subParts[0]
 
 	tags = make(map[string]interface{}, len(subParts)-1)
@@ -121,16 +274,36 @@ func getBucketTagsValue(part string) (bucket string, tags map[string]interface{}
 		}
 	}
 
-	var fval float64
-	if fval, err = strconv.ParseFloat(parts[1], 64); err == nil {
-		if fval == float64(int(fval)) {
-			val = int(fval)
-		} else {
-			return bucket, tags, 0, errors.New("failed to parse the value to an int " + parts[1])
-		}
+	return bucket, tags, parts[1], nil
+}
+
+// getSampleRate parses the @<sample rate> part. The rate tells which fraction
+// of the occurrences the client actually sent, so it must be in (0, 1].
+func getSampleRate(part string) (float64, error) {
+	part = strings.TrimSpace(part)
+	if !strings.HasPrefix(part, "@") {
+		return 0, invalid(ReasonSampleRate, "Expecting @<sample rate> but was %v", part)
+	}
+	rate, err := strconv.ParseFloat(part[1:], 64)
+	if err != nil {
+		return 0, invalid(ReasonSampleRate, "failed to parse the sample rate %v", part)
 	}
+	if !(rate > 0 && rate <= 1) {
+		return 0, invalid(ReasonSampleRate, "sample rate %v is not within (0, 1]", rate)
+	}
+	return rate, nil
+}
 
-	return bucket, tags, val, err
+// maxExactInt is the largest integer a float64 can hold without losing precision.
+const maxExactInt = 1 << 53
+
+// counterValue keeps whole counter values as int64, so they are indexed
+// exactly like before fractional values were accepted.
+func counterValue(val float64) interface{} {
+	if val == math.Trunc(val) && math.Abs(val) <= maxExactInt {
+		return int64(val)
+	}
+	return val
 }
 
 //accounts.authentication.password.failure.no_email_found
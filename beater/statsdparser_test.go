@@ -1,11 +1,15 @@
 package beater
 
 import (
+	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
+
+	"github.com/sentient/statsdbeat/config"
 )
 
 func Test_getBucketTagsValue(t *testing.T) {
@@ -17,7 +21,7 @@ func Test_getBucketTagsValue(t *testing.T) {
 		args       args
 		wantBucket string
 		wantTags   map[string]interface{}
-		wantVal    int
+		wantVal    float64
 		wantErr    bool
 	}{
 		{"testSimple", args{"myCounter:1"},
@@ -73,7 +77,25 @@ func Test_getBucketTagsValue(t *testing.T) {
 			1,
 			false,
 		},
-		{"testInvalidFloat", args{"myCounter:1.1"},
+		{"testFraction", args{"myCounter:1.1"},
+			"myCounter",
+			map[string]interface{}{},
+			1.1,
+			false,
+		},
+		{"testNegativeFraction", args{"gas_tank:-0.75"},
+			"gas_tank",
+			map[string]interface{}{},
+			-0.75,
+			false,
+		},
+		{"testMissingValue", args{"myCounter"},
+			"",
+			nil,
+			0,
+			true,
+		},
+		{"testNaN", args{"myCounter:NaN"},
 			"myCounter",
 			map[string]interface{}{},
 			0,
@@ -82,7 +104,7 @@ func Test_getBucketTagsValue(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotBucket, gotTags, gotVal, err := getBucketTagsValue(tt.args.part)
+			gotBucket, gotTags, gotVal, err := getBucketTagsValue(tt.args.part, config.DialectInfluxDB)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("getBucketTagsValue() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -100,6 +122,34 @@ func Test_getBucketTagsValue(t *testing.T) {
 	}
 }
 
+func Test_splitBucketTagsValueDialects(t *testing.T) {
+	tests := []struct {
+		dialect    string
+		part       string
+		wantBucket string
+		wantTags   map[string]interface{}
+	}{
+		{config.DialectInfluxDB, "requests,env=prod,dc=eu:1", "requests", map[string]interface{}{"env": "prod", "dc": "eu"}},
+		{config.DialectGraphite, "requests;env=prod;dc=eu:1", "requests", map[string]interface{}{"env": "prod", "dc": "eu"}},
+		{config.DialectGraphite, "requests,env=prod:1", "requests,env=prod", map[string]interface{}{}},
+		{config.DialectDogStatsD, "requests,env=prod:1", "requests,env=prod", map[string]interface{}{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			gotBucket, gotTags, gotValue, err := splitBucketTagsValue(tt.part, tt.dialect)
+			if err != nil || gotValue != "1" {
+				t.Errorf("splitBucketTagsValue() value = %v, error = %v", gotValue, err)
+			}
+			if gotBucket != tt.wantBucket {
+				t.Errorf("splitBucketTagsValue() gotBucket = %v, want %v", gotBucket, tt.wantBucket)
+			}
+			if !reflect.DeepEqual(gotTags, tt.wantTags) {
+				t.Errorf("splitBucketTagsValue() gotTags = %v, want %v", gotTags, tt.wantTags)
+			}
+		})
+	}
+}
+
 func Test_parseBeat(t *testing.T) {
 	type args struct {
 		msg string
@@ -118,7 +168,7 @@ func Test_parseBeat(t *testing.T) {
 						"statsd.bucket": "myCounter",
 						"statsd.target": "myCounter",
 						"statsd.type":   "counter",
-						"statsd.value":  1,
+						"statsd.value":  int64(1),
 					},
 				},
 			},
@@ -132,7 +182,7 @@ func Test_parseBeat(t *testing.T) {
 						"statsd.bucket": "myCounter",
 						"statsd.target": "myCounter",
 						"statsd.type":   "counter",
-						"statsd.value":  1,
+						"statsd.value":  int64(1),
 						"statsd.ctx": map[string]interface{}{
 							"myTag": "error",
 							"tagB":  "2",
@@ -153,7 +203,7 @@ func Test_parseBeat(t *testing.T) {
 						"statsd.target":    "gauge",
 						"statsd.action":    "num_goroutine",
 						"statsd.type":      "gauge",
-						"statsd.value":     4,
+						"statsd.value":     float64(4),
 					},
 				},
 			},
@@ -170,7 +220,7 @@ func Test_parseBeat(t *testing.T) {
 						"statsd.target":    "histogram",
 						"statsd.action":    "not_sure",
 						"statsd.type":      "histogram",
-						"statsd.value":     17,
+						"statsd.value":     float64(17),
 					},
 				},
 			},
@@ -187,7 +237,194 @@ func Test_parseBeat(t *testing.T) {
 						"statsd.target":    "timing",
 						"statsd.action":    "ping",
 						"statsd.type":      "timing",
-						"statsd.value":     100,
+						"statsd.value":     float64(100),
+					},
+				},
+			},
+			false,
+		},
+		{"testFractionalTiming",
+			args{"login.time:22.5|ms"},
+			[]beat.Event{
+				{
+					Fields: common.MapStr{
+						"statsd.bucket": "login.time",
+						"statsd.target": "login",
+						"statsd.action": "time",
+						"statsd.type":   "timing",
+						"statsd.value":  22.5,
+					},
+				},
+			},
+			false,
+		},
+		{"testFractionalCounter",
+			args{"myCounter:0.5|c"},
+			[]beat.Event{
+				{
+					Fields: common.MapStr{
+						"statsd.bucket": "myCounter",
+						"statsd.target": "myCounter",
+						"statsd.type":   "counter",
+						"statsd.value":  0.5,
+					},
+				},
+			},
+			false,
+		},
+		{"testSampledCounter",
+			args{"myCounter:3|c|@0.1"},
+			[]beat.Event{
+				{
+					Fields: common.MapStr{
+						"statsd.bucket":      "myCounter",
+						"statsd.target":      "myCounter",
+						"statsd.type":        "counter",
+						"statsd.value":       int64(30),
+						"statsd.sample_rate": 0.1,
+					},
+				},
+			},
+			false,
+		},
+		{"testSampledTiming",
+			args{"login.time:22|ms|@0.5"},
+			[]beat.Event{
+				{
+					Fields: common.MapStr{
+						"statsd.bucket":      "login.time",
+						"statsd.target":      "login",
+						"statsd.action":      "time",
+						"statsd.type":        "timing",
+						"statsd.value":       float64(22),
+						"statsd.sample_rate": 0.5,
+					},
+				},
+			},
+			false,
+		},
+		{"testInvalidSampleRate",
+			args{"myCounter:1|c|@0"},
+			[]beat.Event{},
+			true,
+		},
+		{"testMissingSampleRatePrefix",
+			args{"myCounter:1|c|0.5"},
+			[]beat.Event{},
+			true,
+		},
+		{"testSet",
+			args{"unique_users:foo|s\nunique_users:foo|s\nunique_users:bar|s"},
+			[]beat.Event{
+				{
+					Fields: common.MapStr{
+						"statsd.bucket": "unique_users",
+						"statsd.target": "unique_users",
+						"statsd.type":   "set",
+						"statsd.value":  int64(2),
+					},
+				},
+			},
+			false,
+		},
+		{"testSetMissingMember",
+			args{"unique_users:|s"},
+			[]beat.Event{},
+			true,
+		},
+		{"testDogStatsDTags",
+			args{"metric:1|c|@0.5|#env:prod,region:eu,canary"},
+			[]beat.Event{
+				{
+					Fields: common.MapStr{
+						"statsd.bucket":      "metric",
+						"statsd.target":      "metric",
+						"statsd.type":        "counter",
+						"statsd.value":       int64(2),
+						"statsd.sample_rate": 0.5,
+						"statsd.ctx": map[string]interface{}{
+							"env":    "prod",
+							"region": "eu",
+							"canary": true,
+						},
+					},
+				},
+			},
+			false,
+		},
+		{"testDogStatsDTagsFirst",
+			args{"metric,team=a:3|ms|#url:http://x/y|@0.5"},
+			[]beat.Event{
+				{
+					Fields: common.MapStr{
+						"statsd.bucket":      "metric",
+						"statsd.target":      "metric",
+						"statsd.type":        "timing",
+						"statsd.value":       float64(3),
+						"statsd.sample_rate": 0.5,
+						"statsd.ctx": map[string]interface{}{
+							"team": "a",
+							"url":  "http://x/y",
+						},
+					},
+				},
+			},
+			false,
+		},
+		{"testDogStatsDExtensions",
+			args{"metric:1|c|#env:prod|c:83c0a99c0a54|T1656581400|@0.5"},
+			[]beat.Event{
+				{
+					Fields: common.MapStr{
+						"statsd.bucket":      "metric",
+						"statsd.target":      "metric",
+						"statsd.type":        "counter",
+						"statsd.value":       int64(2),
+						"statsd.sample_rate": 0.5,
+						"statsd.ctx": map[string]interface{}{
+							"env": "prod",
+						},
+					},
+				},
+			},
+			false,
+		},
+		{"testInvalidTimestamp",
+			args{"metric:1|c|Tyesterday"},
+			[]beat.Event{},
+			true,
+		},
+		{"testDuplicateSampleRate",
+			args{"metric:1|c|@0.5|@0.5"},
+			[]beat.Event{},
+			true,
+		},
+		{"testUnknownPart",
+			args{"metric:1|c|x"},
+			[]beat.Event{},
+			true,
+		},
+		{"testDogStatsDEvent",
+			args{"myCounter:1|c\n_e{6,4}:deploy|text|#env:prod"},
+			[]beat.Event{
+				{
+					Fields: common.MapStr{
+						"statsd.type":             "event",
+						"statsd.event.title":      "deploy",
+						"statsd.event.text":       "text",
+						"statsd.event.priority":   "normal",
+						"statsd.event.alert_type": "info",
+						"statsd.ctx": map[string]interface{}{
+							"env": "prod",
+						},
+					},
+				},
+				{
+					Fields: common.MapStr{
+						"statsd.bucket": "myCounter",
+						"statsd.target": "myCounter",
+						"statsd.type":   "counter",
+						"statsd.value":  int64(1),
 					},
 				},
 			},
@@ -258,7 +495,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.bucket": "myCounter",
 						"statsd.target": "myCounter",
 						"statsd.type":   "counter",
-						"statsd.value":  1,
+						"statsd.value":  int64(1),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -275,7 +512,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.bucket": "myCounter",
 						"statsd.target": "myCounter",
 						"statsd.type":   "counter",
-						"statsd.value":  1,
+						"statsd.value":  int64(1),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -286,7 +523,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.bucket": "cnt2",
 						"statsd.target": "cnt2",
 						"statsd.type":   "counter",
-						"statsd.value":  2,
+						"statsd.value":  int64(2),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -297,7 +534,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.bucket": "cnt3",
 						"statsd.target": "cnt3",
 						"statsd.type":   "counter",
-						"statsd.value":  3,
+						"statsd.value":  int64(3),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -308,7 +545,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.bucket": "cnt4",
 						"statsd.target": "cnt4",
 						"statsd.type":   "counter",
-						"statsd.value":  4,
+						"statsd.value":  int64(4),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -319,7 +556,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.bucket": "cnt5",
 						"statsd.target": "cnt5",
 						"statsd.type":   "counter",
-						"statsd.value":  5,
+						"statsd.value":  int64(5),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -336,7 +573,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.bucket": "myCounterAsFloat",
 						"statsd.target": "myCounterAsFloat",
 						"statsd.type":   "counter",
-						"statsd.value":  1,
+						"statsd.value":  int64(1),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -363,7 +600,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.target":    "runtime",
 						"statsd.action":    "num_goroutines",
 						"statsd.type":      "gauge",
-						"statsd.value":     20,
+						"statsd.value":     float64(20),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -377,7 +614,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.target":    "runtime",
 						"statsd.action":    "alloc_bytes",
 						"statsd.type":      "gauge",
-						"statsd.value":     4523224,
+						"statsd.value":     float64(4523224),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -391,7 +628,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.target":    "runtime",
 						"statsd.action":    "sys_bytes",
 						"statsd.type":      "gauge",
-						"statsd.value":     72022264,
+						"statsd.value":     float64(72022264),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -405,7 +642,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.target":    "runtime",
 						"statsd.action":    "malloc_count",
 						"statsd.type":      "gauge",
-						"statsd.value":     96671,
+						"statsd.value":     float64(96671),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -419,7 +656,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.target":    "runtime",
 						"statsd.action":    "free_count",
 						"statsd.type":      "gauge",
-						"statsd.value":     77885,
+						"statsd.value":     float64(77885),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -433,7 +670,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.target":    "runtime",
 						"statsd.action":    "heap_objects",
 						"statsd.type":      "gauge",
-						"statsd.value":     18786,
+						"statsd.value":     float64(18786),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -447,7 +684,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.target":    "runtime",
 						"statsd.action":    "total_gc_pause_ns",
 						"statsd.type":      "gauge",
-						"statsd.value":     3770211,
+						"statsd.value":     float64(3770211),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -461,7 +698,7 @@ func TestParseBeats(t *testing.T) {
 						"statsd.target":    "runtime",
 						"statsd.action":    "total_gc_runs",
 						"statsd.type":      "gauge",
-						"statsd.value":     5,
+						"statsd.value":     float64(5),
 						"statsd": map[string]interface{}{
 							"ctx": map[string]interface{}{},
 						},
@@ -498,6 +735,36 @@ func TestParseBeats(t *testing.T) {
 	}
 }
 
+func Test_getSampleRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		part    string
+		want    float64
+		wantErr bool
+	}{
+		{"full", "@1", 1, false},
+		{"tenth", "@0.1", 0.1, false},
+		{"spaces", " @0.25 ", 0.25, false},
+		{"noPrefix", "0.1", 0, true},
+		{"zero", "@0", 0, true},
+		{"negative", "@-0.5", 0, true},
+		{"aboveOne", "@1.5", 0, true},
+		{"notANumber", "@abc", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getSampleRate(tt.part)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getSampleRate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("getSampleRate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_splitBucket(t *testing.T) {
 	type args struct {
 		bucket string
@@ -534,3 +801,48 @@ func Test_splitBucket(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseMessageLenient(t *testing.T) {
+	msg := "good:1|c\nbad\nvalue:x|c\ntype:1|q\nrate:1|c|@2\n\n_e{3,1}:abc|\n_sc|check|9\ngauge:2|g"
+	metrics, events, errs := parseMessage(msg, config.DialectInfluxDB)
+
+	if len(metrics) != 2 || metrics[0].bucket != "good" || metrics[1].bucket != "gauge" {
+		t.Errorf("parseMessage() kept metrics %v, want good and gauge", metrics)
+	}
+	if len(events) != 0 {
+		t.Errorf("parseMessage() kept events %v, want none", events)
+	}
+
+	want := []ParseError{
+		{Line: 1, Text: "bad", Reason: ReasonFormat},
+		{Line: 2, Text: "value:x|c", Reason: ReasonValue},
+		{Line: 3, Text: "type:1|q", Reason: ReasonType},
+		{Line: 4, Text: "rate:1|c|@2", Reason: ReasonSampleRate},
+		{Line: 6, Text: "_e{3,1}:abc|", Reason: ReasonEvent},
+		{Line: 7, Text: "_sc|check|9", Reason: ReasonServiceCheck},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("parseMessage() errors = %v, want %d", errs, len(want))
+	}
+	for i, w := range want {
+		if errs[i].Line != w.Line || errs[i].Text != w.Text || errs[i].Reason != w.Reason || errs[i].Err == nil {
+			t.Errorf("parseMessage() error %d = %+v, want %+v", i, *errs[i], w)
+		}
+	}
+
+	_, err := ParseBeats(msg)
+	var perr *ParseError
+	if !errors.As(err, &perr) || perr.Line != 1 {
+		t.Errorf("ParseBeats() error = %v, want the ParseError of line 1", err)
+	}
+}
+
+func Test_parseMetricTimestamp(t *testing.T) {
+	m, err := parseMetric("metric:1|c|T1656581400", config.DialectInfluxDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Unix(1656581400, 0); !m.timestamp.Equal(want) {
+		t.Errorf("parseMetric() timestamp = %v, want %v", m.timestamp, want)
+	}
+}
@@ -0,0 +1,172 @@
+package beater
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+// streamServer reads newline delimited statsd lines from many connections,
+// accepted from a tcp or unix socket.
+type streamServer struct {
+	listener net.Listener
+	config   config.TCPConfig
+	handle   func(msg string, addr net.Addr)
+	rejected *rejectCounters
+	log      *logp.Logger
+
+	mux   sync.Mutex
+	conns map[net.Conn]struct{}
+	// the connections are read until then once the server is closing
+	drainUntil time.Time
+	wg         sync.WaitGroup
+}
+
+func newStreamServer(l net.Listener, c config.TCPConfig, handle func(string, net.Addr), rejected *rejectCounters, log *logp.Logger) *streamServer {
+	return &streamServer{
+		listener: l,
+		config:   c,
+		handle:   handle,
+		rejected: rejected,
+		log:      log,
+		conns:    map[net.Conn]struct{}{},
+	}
+}
+
+// serve accepts connections until the server is closed. It returns the
+// accept error that stopped it before it was closed.
+func (s *streamServer) serve() error {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				s.log.Warnf("failed to accept connection. Error %v", err)
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			if s.closed() {
+				return nil
+			}
+			s.log.Errorf("failed to accept connection, stopped accepting on %v. Error %v", s.listener.Addr(), err)
+			return fmt.Errorf("accept on %v: %w", s.listener.Addr(), err)
+		}
+		if !s.track(conn) {
+			conn.Close()
+			return nil
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer s.untrack(conn)
+			s.read(conn)
+		}()
+	}
+}
+
+// track returns false once the server is closed.
+func (s *streamServer) track(conn net.Conn) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.conns == nil {
+		return false
+	}
+	s.conns[conn] = struct{}{}
+	return true
+}
+
+// closed returns true once close was called.
+func (s *streamServer) closed() bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.conns == nil
+}
+
+func (s *streamServer) untrack(conn net.Conn) {
+	conn.Close()
+	s.mux.Lock()
+	delete(s.conns, conn)
+	s.mux.Unlock()
+}
+
+// read handles the lines of one connection. The complete lines that are
+// already buffered are handled together as one message.
+func (s *streamServer) read(conn net.Conn) {
+	addr := conn.RemoteAddr()
+	r := bufio.NewReaderSize(conn, s.config.MaxLineLength)
+	var msg strings.Builder
+	tooLong := false
+	for {
+		s.setReadDeadline(conn)
+		line, err := r.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			// drop the line, up to its newline
+			if !tooLong {
+				s.rejected.add(&ParseError{Reason: ReasonTruncated})
+				s.log.Warnf("Dropped a line from %v longer than max_line_length %d", addr, s.config.MaxLineLength)
+			}
+			tooLong = true
+			continue
+		}
+
+		switch {
+		case tooLong:
+			// the end of the dropped line
+			tooLong = false
+		case err == nil:
+			msg.Write(line)
+			if buffered, _ := r.Peek(r.Buffered()); bytes.IndexByte(buffered, '\n') >= 0 {
+				continue
+			}
+		case err == io.EOF:
+			// the client closed the connection after the last line
+			msg.Write(line)
+		}
+
+		if msg.Len() > 0 {
+			s.handle(msg.String(), addr)
+			msg.Reset()
+		}
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Timeout() {
+				s.log.Debugf("Closed idle connection from %v", addr)
+			}
+			return
+		}
+	}
+}
+
+// setReadDeadline sets the idle timeout of the connection, or the end of the
+// drain once the server is closing.
+func (s *streamServer) setReadDeadline(conn net.Conn) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	switch {
+	case !s.drainUntil.IsZero():
+		conn.SetReadDeadline(s.drainUntil)
+	case s.config.IdleTimeout > 0:
+		conn.SetReadDeadline(time.Now().Add(s.config.IdleTimeout))
+	}
+}
+
+// close stops accepting, reads what the connections send until drainUntil,
+// then closes them and waits for their readers.
+func (s *streamServer) close(drainUntil time.Time) {
+	s.listener.Close()
+	s.mux.Lock()
+	s.drainUntil = drainUntil
+	for conn := range s.conns {
+		conn.SetReadDeadline(drainUntil)
+	}
+	s.conns = nil
+	s.mux.Unlock()
+	s.wg.Wait()
+}
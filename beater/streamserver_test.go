@@ -0,0 +1,128 @@
+package beater
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+func Test_streamServerTCP(t *testing.T) {
+	var mux sync.Mutex
+	var received []string
+	handle := func(msg string, _ net.Addr) {
+		mux.Lock()
+		received = append(received, strings.Split(strings.TrimSuffix(msg, "\n"), "\n")...)
+		mux.Unlock()
+	}
+	rejected := &rejectCounters{}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newStreamServer(l, config.TCPConfig{IdleTimeout: time.Second, MaxLineLength: 16}, handle, rejected, logp.NewLogger("test"))
+	go s.serve()
+
+	writes := []string{
+		"a:1|c\nb:2", // b is split over two reads
+		"|c\nthis.line.is.too.long:1|c\n",
+		"c:3|c\nd:4|c",
+	}
+	for i := 0; i < 3; i++ {
+		conn, err := net.Dial("tcp", s.listener.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, w := range writes {
+			if _, err := conn.Write([]byte(w)); err != nil {
+				t.Fatal(err)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		conn.Close()
+	}
+
+	want := []string{"a:1|c", "b:2|c", "c:3|c", "d:4|c"}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		mux.Lock()
+		n := len(received)
+		mux.Unlock()
+		if n >= 3*len(want) || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.close(time.Now())
+
+	mux.Lock()
+	defer mux.Unlock()
+	if len(received) != 3*len(want) {
+		t.Fatalf("streamServer handled %q, want 3 times %q", received, want)
+	}
+	// the connections are read concurrently, only the lines of one are ordered
+	counts := map[string]int{}
+	for _, line := range received {
+		counts[line]++
+	}
+	for _, line := range want {
+		if counts[line] != 3 {
+			t.Errorf("streamServer handled %q %d times, want 3", line, counts[line])
+		}
+	}
+	if got := rejected.String(); got != "truncated=3" {
+		t.Errorf("streamServer rejected %v, want truncated=3", got)
+	}
+}
+
+func Test_streamServerIdleTimeout(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newStreamServer(l, config.TCPConfig{IdleTimeout: 50 * time.Millisecond, MaxLineLength: 64}, func(string, net.Addr) {}, &rejectCounters{}, logp.NewLogger("test"))
+	go s.serve()
+	defer s.close(time.Now())
+
+	conn, err := net.Dial("tcp", s.listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := conn.Read(make([]byte, 1)); err == nil || isTimeout(err) {
+		t.Errorf("idle connection was not closed by the server, read error = %v", err)
+	}
+}
+
+func isTimeout(err error) bool {
+	ne, ok := err.(net.Error)
+	return ok && ne.Timeout()
+}
+
+func Test_streamServerAcceptErrors(t *testing.T) {
+	temporary := &net.OpError{Op: "accept", Net: "tcp", Err: syscall.EMFILE}
+	handle := func(string, net.Addr) {}
+
+	l := &failingListener{errs: []error{temporary, errors.New("broken listener")}, closed: make(chan struct{})}
+	s := newStreamServer(l, config.TCPConfig{}, handle, &rejectCounters{}, logp.NewLogger("test"))
+	if err := s.serve(); err == nil {
+		t.Error("serve() without an error after a permanent accept error")
+	}
+
+	l = &failingListener{errs: []error{temporary}, closed: make(chan struct{})}
+	s = newStreamServer(l, config.TCPConfig{}, handle, &rejectCounters{}, logp.NewLogger("test"))
+	served := make(chan error, 1)
+	go func() { served <- s.serve() }()
+	s.close(time.Now())
+	if err := <-served; err != nil {
+		t.Errorf("serve() error = %v after the server was closed", err)
+	}
+}
@@ -0,0 +1,97 @@
+package beater
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// timer keeps the samples of a timer or histogram during a period and
+// summarizes them like the etsy statsd server does.
+type timer struct {
+	_type       string
+	percentiles []float64
+	values      []float64
+	count       float64 // sample rate corrected
+}
+
+func newTimer(_type string, percentiles []float64) *timer {
+	return &timer{
+		_type:       _type,
+		percentiles: percentiles,
+	}
+}
+
+func (t *timer) add(m *metric) {
+	t.values = append(t.values, m.value)
+	t.count += 1 / m.sampleRate
+}
+
+func (t *timer) fields(interval time.Duration) common.MapStr {
+	values := t.values
+	sort.Float64s(values)
+	n := len(values)
+
+	cumulative := make([]float64, n)
+	sum := 0.0
+	for i, v := range values {
+		sum += v
+		cumulative[i] = sum
+	}
+	mean := sum / float64(n)
+
+	variance := 0.0
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+
+	f := common.MapStr{
+		"statsd.type":   metricTypes[t._type],
+		"statsd.count":  t.count,
+		"statsd.rate":   t.count / interval.Seconds(),
+		"statsd.sum":    sum,
+		"statsd.mean":   mean,
+		"statsd.median": percentile(values, 50),
+		"statsd.stddev": math.Sqrt(variance / float64(n)),
+		"statsd.min":    values[0],
+		"statsd.max":    values[n-1],
+	}
+
+	percentiles := common.MapStr{}
+	for _, pct := range t.percentiles {
+		name := percentileName(pct)
+		percentiles["p"+name] = percentile(values, pct)
+
+		// etsy statsd threshold metrics, over the lowest pct% of the samples
+		inThreshold := int(math.Round(pct / 100 * float64(n)))
+		if inThreshold == 0 {
+			continue
+		}
+		f["statsd.count_"+name] = inThreshold
+		f["statsd.upper_"+name] = values[inThreshold-1]
+		f["statsd.sum_"+name] = cumulative[inThreshold-1]
+		f["statsd.mean_"+name] = cumulative[inThreshold-1] / float64(inThreshold)
+	}
+	if len(percentiles) > 0 {
+		f["statsd.percentiles"] = percentiles
+	}
+	return f
+}
+
+// percentile interpolates linearly between the closest ranks of the sorted
+// values.
+func percentile(sorted []float64, pct float64) float64 {
+	rank := pct / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+// percentileName formats 99.9 as 99_9, dots are field separators.
+func percentileName(pct float64) string {
+	return strings.Replace(strconv.FormatFloat(pct, 'f', -1, 64), ".", "_", 1)
+}
@@ -0,0 +1,78 @@
+package beater
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func Test_timerFields(t *testing.T) {
+	tm := newTimer("ms", []float64{50, 90, 99.9})
+	for _, v := range []float64{10, 2, 8, 4, 6, 1, 9, 3, 7, 5} {
+		tm.add(&metric{_type: "ms", value: v, sampleRate: 0.5})
+	}
+	got := tm.fields(10 * time.Second).Flatten()
+
+	want := common.MapStr{
+		"statsd.type":              "timing",
+		"statsd.count":             20.0,
+		"statsd.rate":              2.0,
+		"statsd.sum":               55.0,
+		"statsd.mean":              5.5,
+		"statsd.median":            5.5,
+		"statsd.stddev":            math.Sqrt(8.25),
+		"statsd.min":               1.0,
+		"statsd.max":               10.0,
+		"statsd.percentiles.p50":   5.5,
+		"statsd.percentiles.p90":   9.1,
+		"statsd.percentiles.p99_9": 9.991,
+		"statsd.count_50":          5,
+		"statsd.upper_50":          5.0,
+		"statsd.sum_50":            15.0,
+		"statsd.mean_50":           3.0,
+		"statsd.count_90":          9,
+		"statsd.upper_90":          9.0,
+		"statsd.sum_90":            45.0,
+		"statsd.mean_90":           5.0,
+		"statsd.count_99_9":        10,
+		"statsd.upper_99_9":        10.0,
+		"statsd.sum_99_9":          55.0,
+		"statsd.mean_99_9":         5.5,
+	}
+	if len(got) != len(want) {
+		t.Errorf("timer.fields() = \n%v, want \n%v", got, want)
+	}
+	for k, w := range want {
+		g := got[k]
+		if wf, ok := w.(float64); ok {
+			if gf, ok := g.(float64); !ok || math.Abs(gf-wf) > 1e-9 {
+				t.Errorf("timer.fields() %v = %v, want %v", k, g, w)
+			}
+		} else if g != w {
+			t.Errorf("timer.fields() %v = %v, want %v", k, g, w)
+		}
+	}
+}
+
+func Test_percentile(t *testing.T) {
+	tests := []struct {
+		name   string
+		sorted []float64
+		pct    float64
+		want   float64
+	}{
+		{"single", []float64{7}, 90, 7},
+		{"median odd", []float64{1, 2, 3}, 50, 2},
+		{"median even", []float64{1, 2, 3, 4}, 50, 2.5},
+		{"max", []float64{1, 2, 3, 4}, 100, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := percentile(tt.sorted, tt.pct); got != tt.want {
+				t.Errorf("percentile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package beater
+
+import (
+	"context"
+	"fmt"
+	"net"
+)
+
+// listenUDP opens the sockets for the readers of an udp input. With
+// SO_REUSEPORT every reader gets its own socket, otherwise they share one.
+func listenUDP(address string, readers int, receiveBufferSize int) ([]net.PacketConn, error) {
+	sockets := 1
+	if reusePort {
+		sockets = readers
+	}
+
+	var conns []net.PacketConn
+	closeAll := func() {
+		for _, conn := range conns {
+			conn.Close()
+		}
+	}
+	lc := reusePortConfig()
+	for i := 0; i < sockets; i++ {
+		pc, err := lc.ListenPacket(context.Background(), "udp", address)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		conn := pc.(*net.UDPConn)
+		conns = append(conns, conn)
+		if receiveBufferSize > 0 {
+			if err = conn.SetReadBuffer(receiveBufferSize); err != nil {
+				closeAll()
+				return nil, fmt.Errorf("Failed to set the receive buffer size to %d: %v", receiveBufferSize, err)
+			}
+		}
+		// the next sockets bind the same port, also when it was chosen by the OS
+		address = conn.LocalAddr().String()
+	}
+	return conns, nil
+}
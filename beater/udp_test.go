@@ -0,0 +1,166 @@
+package beater
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+func Test_readDatagrams(t *testing.T) {
+	c := config.DefaultConfig
+	c.Readers = 4
+	c.Workers = 2
+	bt := newStatsdbeat(c)
+
+	conns, err := listenUDP("127.0.0.1:0", c.Readers, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reusePort && len(conns) != c.Readers {
+		t.Errorf("listenUDP() opened %d sockets, want %d", len(conns), c.Readers)
+	}
+	for _, conn := range conns {
+		if conn.LocalAddr().String() != conns[0].LocalAddr().String() {
+			t.Errorf("socket on %v, want %v", conn.LocalAddr(), conns[0].LocalAddr())
+		}
+	}
+
+	var mux sync.Mutex
+	received := map[string]bool{}
+	var g errgroup.Group
+	closeReaders := bt.readDatagrams(&g, conns, c.Readers, func(msg string, _ net.Addr) {
+		mux.Lock()
+		received[msg] = true
+		mux.Unlock()
+	})
+
+	// the kernel balances the datagrams by source address
+	for i := 0; i < 20; i++ {
+		client, err := net.Dial("udp", conns[0].LocalAddr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprintf(client, "bucket%d:1|c", i)
+		client.Close()
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		mux.Lock()
+		n := len(received)
+		mux.Unlock()
+		if n == 20 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	closeReaders(time.Now())
+	if err := g.Wait(); err != nil {
+		t.Errorf("readers error = %v", err)
+	}
+
+	if len(received) != 20 {
+		t.Errorf("received %d datagrams, want 20", len(received))
+	}
+}
+
+// brokenConn fails every read, like a socket that can not be read anymore.
+type brokenConn struct {
+	net.PacketConn
+}
+
+func (brokenConn) ReadFrom([]byte) (int, net.Addr, error) {
+	return 0, nil, errors.New("broken socket")
+}
+
+func (brokenConn) SetReadDeadline(time.Time) error { return nil }
+func (brokenConn) Close() error                    { return nil }
+func (brokenConn) LocalAddr() net.Addr             { return &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)} }
+
+func Test_readDatagramsReturnsReadErrors(t *testing.T) {
+	bt := newStatsdbeat(config.DefaultConfig)
+	var g errgroup.Group
+	closeReaders := bt.readDatagrams(&g, []net.PacketConn{brokenConn{}}, 1, func(string, net.Addr) {})
+	if err := g.Wait(); err == nil {
+		t.Error("readers without an error, want the read error")
+	}
+	closeReaders(time.Now())
+}
+
+// BenchmarkUDPInput reports the packets per second the udp input parses with
+// more readers and workers, and with batched reads. Packets the kernel
+// dropped are not counted.
+func BenchmarkUDPInput(b *testing.B) {
+	var lines []string
+	for i := 0; i < 20; i++ {
+		lines = append(lines, fmt.Sprintf("service.requests,host=web%d,env=prod:%d|ms|@0.5", i, i))
+	}
+	packet := []byte(strings.Join(lines, "\n"))
+
+	benchmarks := []struct{ readers, batch int }{
+		{1, 0}, {2, 0}, {4, 0}, {8, 0}, {1, 32}, {4, 32},
+	}
+	for _, bm := range benchmarks {
+		b.Run(fmt.Sprintf("readers=%d/batch=%d", bm.readers, bm.batch), func(b *testing.B) {
+			c := config.DefaultConfig
+			c.Readers = bm.readers
+			c.Workers = bm.readers
+			c.ReadBatch = bm.batch
+			c.ReceiveBufferSize = 4 << 20
+			bt := newStatsdbeat(c)
+
+			conns, err := listenUDP("127.0.0.1:0", c.Readers, c.ReceiveBufferSize)
+			if err != nil {
+				b.Fatal(err)
+			}
+			var parsed int64
+			var g errgroup.Group
+			closeReaders := bt.readDatagrams(&g, conns, c.Readers, func(msg string, _ net.Addr) {
+				parseMessage(msg, config.DialectInfluxDB)
+				atomic.AddInt64(&parsed, 1)
+			})
+
+			// several senders, the kernel balances by their source port
+			const senders = 8
+			var wg sync.WaitGroup
+			b.ResetTimer()
+			start := time.Now()
+			for s := 0; s < senders; s++ {
+				wg.Add(1)
+				go func(count int) {
+					defer wg.Done()
+					client, err := net.Dial("udp", conns[0].LocalAddr().String())
+					if err != nil {
+						b.Error(err)
+						return
+					}
+					defer client.Close()
+					for i := 0; i < count; i++ {
+						client.Write(packet)
+					}
+				}(b.N/senders + 1)
+			}
+			wg.Wait()
+			// wait until the queued packets are parsed
+			for last := int64(-1); last != atomic.LoadInt64(&parsed); {
+				last = atomic.LoadInt64(&parsed)
+				time.Sleep(20 * time.Millisecond)
+			}
+			elapsed := time.Since(start)
+			b.StopTimer()
+			closeReaders(time.Now())
+			g.Wait()
+
+			b.ReportMetric(float64(parsed)/elapsed.Seconds(), "packets/s")
+			b.ReportMetric(100*(1-float64(parsed)/float64(senders*(b.N/senders+1))), "%lost")
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package beater
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+	"syscall"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+// listenUnixgram listens for datagrams on the unix socket of the input.
+func listenUnixgram(c config.InputConfig) (*net.UnixConn, error) {
+	if err := removeStaleSocket(c.Address, "unixgram"); err != nil {
+		return nil, err
+	}
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: c.Address, Net: "unixgram"})
+	if err != nil {
+		return nil, err
+	}
+	if err = setSocketPermissions(c); err != nil {
+		conn.Close()
+		os.Remove(c.Address)
+		return nil, err
+	}
+	return conn, nil
+}
+
+// listenUnix listens for stream connections on the unix socket of the input.
+func listenUnix(c config.InputConfig) (*net.UnixListener, error) {
+	if err := removeStaleSocket(c.Address, "unix"); err != nil {
+		return nil, err
+	}
+	l, err := net.ListenUnix("unix", &net.UnixAddr{Name: c.Address, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	// the socket file is removed by us, also for a datagram socket
+	l.SetUnlinkOnClose(false)
+	if err = setSocketPermissions(c); err != nil {
+		l.Close()
+		os.Remove(c.Address)
+		return nil, err
+	}
+	return l, nil
+}
+
+// removeStaleSocket removes the socket file a previous run left behind,
+// nobody listens on it anymore when connecting is refused. A socket still in
+// use and any other kind of file are left alone.
+func removeStaleSocket(path string, network string) error {
+	fi, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%v exists and is not a unix socket", path)
+	}
+	conn, err := net.Dial(network, path)
+	if err == nil {
+		conn.Close()
+		return fmt.Errorf("%v: address in use", path)
+	}
+	if !errors.Is(err, syscall.ECONNREFUSED) {
+		return fmt.Errorf("%v: address in use: %v", path, err)
+	}
+	return os.Remove(path)
+}
+
+func setSocketPermissions(c config.InputConfig) error {
+	if len(c.Mode) > 0 {
+		mode, err := strconv.ParseUint(c.Mode, 8, 32)
+		if err != nil {
+			return fmt.Errorf("failed to parse the socket mode %v: %v", c.Mode, err)
+		}
+		if err = os.Chmod(c.Address, os.FileMode(mode)); err != nil {
+			return err
+		}
+	}
+	if len(c.Owner) == 0 && len(c.Group) == 0 {
+		return nil
+	}
+
+	uid, gid := -1, -1
+	if len(c.Owner) > 0 {
+		u, err := user.Lookup(c.Owner)
+		if err != nil {
+			if u, err = user.LookupId(c.Owner); err != nil {
+				return fmt.Errorf("failed to find the socket owner %v: %v", c.Owner, err)
+			}
+		}
+		if uid, err = strconv.Atoi(u.Uid); err != nil {
+			return err
+		}
+	}
+	if len(c.Group) > 0 {
+		g, err := user.LookupGroup(c.Group)
+		if err != nil {
+			if g, err = user.LookupGroupId(c.Group); err != nil {
+				return fmt.Errorf("failed to find the socket group %v: %v", c.Group, err)
+			}
+		}
+		if gid, err = strconv.Atoi(g.Gid); err != nil {
+			return err
+		}
+	}
+	return os.Chown(c.Address, uid, gid)
+}
@@ -0,0 +1,103 @@
+package beater
+
+import (
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sentient/statsdbeat/config"
+)
+
+func Test_listenUnixgram(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "statsd.sock")
+	c := config.InputConfig{Protocol: config.ProtocolUnixgram, Address: path, Mode: "0620"}
+
+	// a stale socket of a previous run
+	stale, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale.Close()
+
+	conn, err := listenUnixgram(c)
+	if err != nil {
+		t.Fatalf("listenUnixgram() error = %v", err)
+	}
+	defer conn.Close()
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0620 {
+		t.Errorf("socket mode = %v, want 0620", fi.Mode().Perm())
+	}
+
+	client, err := net.Dial("unixgram", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	if _, err = client.Write([]byte("a:1|c")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 64)
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil || string(buf[:n]) != "a:1|c" {
+		t.Errorf("ReadFrom() = %q, %v, want a:1|c", buf[:n], err)
+	}
+}
+
+func Test_removeStaleSocket(t *testing.T) {
+	dir := t.TempDir()
+	if err := removeStaleSocket(filepath.Join(dir, "missing.sock"), "unixgram"); err != nil {
+		t.Errorf("removeStaleSocket() of a missing file error = %v", err)
+	}
+
+	file := filepath.Join(dir, "statsd.sock")
+	if err := os.WriteFile(file, []byte("data"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := removeStaleSocket(file, "unixgram"); err == nil {
+		t.Errorf("removeStaleSocket() of a regular file did not fail")
+	}
+	if _, err := os.Stat(file); err != nil {
+		t.Errorf("removeStaleSocket() removed a regular file")
+	}
+
+	os.Remove(file)
+
+	// a socket somebody listens on is in use, of either type
+	for _, network := range []string{"unixgram", "unix"} {
+		var l io.Closer
+		var err error
+		if network == "unix" {
+			l, err = net.Listen(network, file)
+		} else {
+			l, err = net.ListenPacket(network, file)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = removeStaleSocket(file, "unixgram"); err == nil {
+			t.Errorf("removeStaleSocket() of a %v socket in use did not fail", network)
+		}
+		if _, err = os.Stat(file); err != nil {
+			t.Errorf("removeStaleSocket() removed a %v socket in use", network)
+		}
+		if ul, ok := l.(*net.UnixListener); ok {
+			// leave the socket file behind, like a crashed process
+			ul.SetUnlinkOnClose(false)
+		}
+		l.Close()
+
+		if err = removeStaleSocket(file, "unixgram"); err != nil {
+			t.Errorf("removeStaleSocket() of a stale %v socket error = %v", network, err)
+		}
+		if _, err = os.Stat(file); !os.IsNotExist(err) {
+			t.Errorf("removeStaleSocket() left a stale %v socket", network)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package beater
+
+import (
+	"hash/fnv"
+	"net"
+	"runtime"
+	"sync"
+)
+
+// workerQueueLength is how many messages wait for a worker before the
+// readers block.
+const workerQueueLength = 1024
+
+type queuedMessage struct {
+	msg  string
+	addr net.Addr
+}
+
+// workerPool handles the messages of the readers with several goroutines.
+// The messages of one source address always go to the same worker, so they
+// are handled in the order they were read, e.g. a gauge and its deltas.
+type workerPool struct {
+	queues []chan queuedMessage
+	wg     sync.WaitGroup
+}
+
+// newWorkerPool starts the workers, one per cpu when workers is 0.
+func newWorkerPool(workers int, handle func(string, net.Addr)) *workerPool {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	p := &workerPool{queues: make([]chan queuedMessage, workers)}
+	p.wg.Add(workers)
+	for i := range p.queues {
+		queue := make(chan queuedMessage, workerQueueLength)
+		p.queues[i] = queue
+		go func() {
+			defer p.wg.Done()
+			for m := range queue {
+				handle(m.msg, m.addr)
+			}
+		}()
+	}
+	return p
+}
+
+// handle queues the message for the worker of its source address.
+func (p *workerPool) handle(msg string, addr net.Addr) {
+	p.queues[p.worker(addr)] <- queuedMessage{msg, addr}
+}
+
+// worker picks the worker by the hash of the address. Messages without an
+// address, e.g. from unbound unix sockets, share the first worker.
+func (p *workerPool) worker(addr net.Addr) int {
+	if len(p.queues) == 1 || addr == nil {
+		return 0
+	}
+	h := fnv.New32a()
+	h.Write([]byte(addr.String()))
+	return int(h.Sum32() % uint32(len(p.queues)))
+}
+
+// close waits until the queued messages are handled. The readers must have
+// stopped calling handle.
+func (p *workerPool) close() {
+	for _, queue := range p.queues {
+		close(queue)
+	}
+	p.wg.Wait()
+}
@@ -0,0 +1,32 @@
+package beater
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func Test_workerPoolKeepsTheOrderOfASender(t *testing.T) {
+	var mux sync.Mutex
+	handled := map[string][]string{}
+	pool := newWorkerPool(8, func(msg string, addr net.Addr) {
+		mux.Lock()
+		handled[addr.String()] = append(handled[addr.String()], msg)
+		mux.Unlock()
+	})
+
+	want := map[string][]string{}
+	for i := 0; i < 1000; i++ {
+		addr := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 9000 + i%4}
+		msg := fmt.Sprintf("g:+%d|g", i)
+		want[addr.String()] = append(want[addr.String()], msg)
+		pool.handle(msg, addr)
+	}
+	pool.close()
+
+	if !reflect.DeepEqual(handled, want) {
+		t.Errorf("the messages of a sender were handled out of order")
+	}
+}
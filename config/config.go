@@ -3,16 +3,412 @@
 
 package config
 
-import "time"
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+)
 
 type Config struct {
-	Period           time.Duration `config:"period"`       //The flush interval from statsd client, to elasticsearch
-	UDPAddress       string        `config:"statsdserver"` //udp listening
-	TCPHealthAddress string        `config:"healthserver"` //tcp listing for health check
+	Period            time.Duration    `config:"period"`              //The flush interval from statsd client, to elasticsearch
+	FlushSize         int              `config:"flush_size"`          //flush the buffered events as soon as there are this many, 0 only flushes each period
+	MaxEventAge       time.Duration    `config:"max_event_age"`       //flush the buffered events when the oldest waited this long, 0 only flushes each period
+	ShutdownGrace     time.Duration    `config:"shutdown_grace"`      //how long the inputs are drained on shutdown, before the last flush
+	Inputs            []InputConfig    `config:"inputs"`              //the listeners, replace statsdserver, tcpserver and unixsocket when set
+	UDPAddress        string           `config:"statsdserver"`        //udp listening
+	TCPHealthAddress  string           `config:"healthserver"`        //tcp listing for health check
+	MaxMessageSize    int              `config:"max_message_size"`    //read buffer for one udp datagram, longer datagrams are truncated
+	ReceiveBufferSize int              `config:"receive_buffer_size"` //SO_RCVBUF of the udp socket, 0 keeps the OS default
+	Readers           int              `config:"readers"`             //goroutines reading each udp input, each with its own SO_REUSEPORT socket on linux
+	Workers           int              `config:"workers"`             //goroutines parsing the datagrams of each udp and unixgram input, 0 is one per cpu
+	ReadBatch         int              `config:"read_batch"`          //udp datagrams read per recvmmsg syscall on linux, 0 or 1 reads them one by one
+	TCPAddress        string           `config:"tcpserver"`           //tcp listening for newline delimited statsd, empty disables it
+	TCP               TCPConfig        `config:"tcp"`                 //limits of the tcp connections
+	UnixSocket        UnixSocketConfig `config:"unixsocket"`          //unix socket listening, datagram or stream
+	Lenient           bool             `config:"lenient"`             //publish the valid lines of a message that has invalid lines
+	Buffer            BufferConfig     `config:"buffer"`              //limits of the events kept until the next flush
+	Spool             SpoolConfig      `config:"spool"`               //keeps the unacknowledged events on disk across restarts
+	PublishMode       string           `config:"publish_mode"`        //guaranteed, drop_if_full or default, the mode of the pipeline
+	WaitClose         time.Duration    `config:"wait_close"`          //how long closing the client waits for the events to be acknowledged
+	PublishRules      []PublishRule    `config:"publish_rules"`       //publish_mode and wait_close of the buckets matching a pattern, the first matching rule wins
+	SelfMetrics       SelfConfig       `config:"self_metrics"`        //publish events about statsdbeat itself each period
+	Sets              SetConfig        `config:"sets"`                //how the unique members of sets are counted
+	Aggregation       Aggregation      `config:"aggregation"`         //which metric types are aggregated per period
+	Gauges            GaugeConfig      `config:"gauges"`              //how long gauges are kept and if they are repeated
+}
+
+// BufferConfig limits the events kept between flushes.
+type BufferConfig struct {
+	MaxEvents int    `config:"max_events"` //events kept until the next flush, 0 is unlimited
+	MaxBytes  int    `config:"max_bytes"`  //estimated size of the events kept until the next flush, 0 is unlimited
+	Overflow  string `config:"overflow"`   //when the buffer is full: drop_newest, drop_oldest or block the readers
+}
+
+const (
+	OverflowDropNewest = "drop_newest"
+	OverflowDropOldest = "drop_oldest"
+	OverflowBlock      = "block"
+)
+
+// Validate is called by the config unpacker.
+func (c *BufferConfig) Validate() error {
+	if c.MaxEvents < 0 {
+		return fmt.Errorf("buffer.max_events must not be negative but was %d", c.MaxEvents)
+	}
+	if c.MaxBytes < 0 {
+		return fmt.Errorf("buffer.max_bytes must not be negative but was %d", c.MaxBytes)
+	}
+	switch c.Overflow {
+	case OverflowDropNewest, OverflowDropOldest, OverflowBlock:
+	default:
+		return fmt.Errorf("buffer.overflow must be %v, %v or %v but was %q", OverflowDropNewest, OverflowDropOldest, OverflowBlock, c.Overflow)
+	}
+	return nil
+}
+
+// SpoolConfig is the directory the events that were not acknowledged are
+// written to, to publish them after the next start.
+type SpoolConfig struct {
+	Enabled  bool   `config:"enabled"`   //write the unacknowledged events on shutdown and the events the pipeline dropped
+	Path     string `config:"path"`      //the spool directory, relative to the data path. Default "spool"
+	MaxBytes int64  `config:"max_bytes"` //the spool files are not written beyond this size
+}
+
+// Validate is called by the config unpacker.
+func (c *SpoolConfig) Validate() error {
+	if c.MaxBytes <= 0 {
+		return fmt.Errorf("spool.max_bytes must be positive but was %d", c.MaxBytes)
+	}
+	return nil
+}
+
+// SelfConfig publishes the health of statsdbeat as statsd events, like
+// the statsd.* metrics of etsy statsd.
+type SelfConfig struct {
+	Enabled bool   `config:"enabled"` //publish the events each period
+	Prefix  string `config:"prefix"`  //prepended to the buckets of the events. Default "statsd."
+}
+
+// GaugeConfig controls the gauge values kept between periods.
+type GaugeConfig struct {
+	Repeat  bool          `config:"repeat"`   //publish the last value of every gauge each period
+	IdleTTL time.Duration `config:"idle_ttl"` //forget gauges not updated for this long, 0 keeps them forever. Default 1h
+}
+
+// Aggregation selects the metric types that are published once per period,
+// instead of one event per received metric.
+type Aggregation struct {
+	Counters    bool      `config:"counters"`    //sum counters per bucket and tags
+	Timers      bool      `config:"timers"`      //summarize timers and histograms per bucket and tags
+	Percentiles []float64 `config:"percentiles"` //percentiles calculated for aggregated timers
+}
+
+// Validate is called by the config unpacker.
+func (c *Aggregation) Validate() error {
+	for _, p := range c.Percentiles {
+		if !(p > 0 && p <= 100) {
+			return fmt.Errorf("aggregation.percentiles must be within (0, 100] but was %v", p)
+		}
+	}
+	return nil
+}
+
+// MaxUDPMessageSize is the largest possible udp datagram.
+const MaxUDPMessageSize = 65536
+
+// Validate is called by the config unpacker.
+func (c *Config) Validate() error {
+	if c.MaxMessageSize <= 0 || c.MaxMessageSize > MaxUDPMessageSize {
+		return fmt.Errorf("max_message_size must be within 1 and %d but was %d", MaxUDPMessageSize, c.MaxMessageSize)
+	}
+	if c.ReceiveBufferSize < 0 {
+		return fmt.Errorf("receive_buffer_size must not be negative but was %d", c.ReceiveBufferSize)
+	}
+	if c.FlushSize < 0 {
+		return fmt.Errorf("flush_size must not be negative but was %d", c.FlushSize)
+	}
+	if c.Buffer.MaxEvents > 0 && c.FlushSize > c.Buffer.MaxEvents {
+		return fmt.Errorf("flush_size %d is never reached with buffer.max_events %d", c.FlushSize, c.Buffer.MaxEvents)
+	}
+	if c.MaxEventAge < 0 {
+		return fmt.Errorf("max_event_age must not be negative but was %v", c.MaxEventAge)
+	}
+	if c.ShutdownGrace < 0 {
+		return fmt.Errorf("shutdown_grace must not be negative but was %v", c.ShutdownGrace)
+	}
+	if c.Readers <= 0 {
+		return fmt.Errorf("readers must be positive but was %d", c.Readers)
+	}
+	if c.Workers < 0 {
+		return fmt.Errorf("workers must not be negative but was %d", c.Workers)
+	}
+	if c.ReadBatch < 0 {
+		return fmt.Errorf("read_batch must not be negative but was %d", c.ReadBatch)
+	}
+	if len(c.PublishMode) == 0 {
+		return fmt.Errorf("publish_mode must be %v, %v or %v but was empty", PublishModeGuaranteed, PublishModeDropIfFull, PublishModeDefault)
+	}
+	return validatePublish("", c.PublishMode, &c.WaitClose)
+}
+
+const (
+	PublishModeGuaranteed = "guaranteed"
+	PublishModeDropIfFull = "drop_if_full"
+	PublishModeDefault    = "default"
+)
+
+// Publish is how the events of a pipeline client are published.
+type Publish struct {
+	Mode      string
+	WaitClose time.Duration
+}
+
+// PublishRule overrides publish_mode and wait_close for the buckets matching
+// one of its patterns.
+type PublishRule struct {
+	Buckets     []string       `config:"buckets"`      //bucket patterns, * matches any characters
+	PublishMode string         `config:"publish_mode"` //empty keeps the publish_mode of the input
+	WaitClose   *time.Duration `config:"wait_close"`   //unset keeps the wait_close of the input
+}
+
+// Validate is called by the config unpacker.
+func (c *PublishRule) Validate() error {
+	if len(c.Buckets) == 0 {
+		return fmt.Errorf("publish_rules.buckets must not be empty")
+	}
+	for _, pattern := range c.Buckets {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("publish_rules.buckets must be patterns but was %q", pattern)
+		}
+	}
+	return validatePublish("publish_rules.", c.PublishMode, c.WaitClose)
+}
+
+// Matches tells whether the bucket matches one of the patterns.
+func (c *PublishRule) Matches(bucket string) bool {
+	for _, pattern := range c.Buckets {
+		if ok, _ := path.Match(pattern, bucket); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply returns p with the settings of the rule.
+func (c *PublishRule) Apply(p Publish) Publish {
+	return overridePublish(p, c.PublishMode, c.WaitClose)
+}
+
+// InputPublish returns how the events of the input are published.
+func (c *Config) InputPublish(in InputConfig) Publish {
+	return overridePublish(Publish{Mode: c.PublishMode, WaitClose: c.WaitClose}, in.PublishMode, in.WaitClose)
+}
+
+func overridePublish(p Publish, mode string, waitClose *time.Duration) Publish {
+	if len(mode) > 0 {
+		p.Mode = mode
+	}
+	if waitClose != nil {
+		p.WaitClose = *waitClose
+	}
+	return p
+}
+
+// validatePublish checks publish_mode and wait_close, an empty mode and a
+// nil wait_close are not set.
+func validatePublish(prefix, mode string, waitClose *time.Duration) error {
+	switch mode {
+	case "", PublishModeGuaranteed, PublishModeDropIfFull, PublishModeDefault:
+	default:
+		return fmt.Errorf("%spublish_mode must be %v, %v or %v but was %q", prefix, PublishModeGuaranteed, PublishModeDropIfFull, PublishModeDefault, mode)
+	}
+	if waitClose != nil && *waitClose < 0 {
+		return fmt.Errorf("%swait_close must not be negative but was %v", prefix, *waitClose)
+	}
+	return nil
+}
+
+// TCPConfig limits the connections of the tcp statsd server.
+type TCPConfig struct {
+	IdleTimeout   time.Duration `config:"idle_timeout"`    //close connections without data for this long, 0 never closes them
+	MaxLineLength int           `config:"max_line_length"` //longer lines are dropped
+}
+
+// Validate is called by the config unpacker.
+func (c *TCPConfig) Validate() error {
+	if c.MaxLineLength < 16 {
+		return fmt.Errorf("tcp.max_line_length must be at least 16 but was %d", c.MaxLineLength)
+	}
+	return nil
+}
+
+// InputConfig is one listener for statsd messages.
+type InputConfig struct {
+	Protocol string            `config:"protocol"` //udp, tcp, unixgram or unix
+	Address  string            `config:"address"`  //host:port, or the socket file for unixgram and unix
+	Dialect  string            `config:"dialect"`  //how tags are put in the bucket: influxdb (bucket,k=v), graphite (bucket;k=v) or dogstatsd (not at all)
+	Tags     map[string]string `config:"tags"`     //static tags added to the metrics, unless they have the tag already
+	Prefix   string            `config:"prefix"`   //prepended to every bucket
+	Mode     string            `config:"mode"`     //unix sockets: octal file mode, e.g. "0660"
+	Owner    string            `config:"owner"`    //unix sockets: user name or id that owns the socket
+	Group    string            `config:"group"`    //unix sockets: group name or id of the socket
+	// empty and unset keep the global settings
+	PublishMode string         `config:"publish_mode"` //guaranteed, drop_if_full or default for the events of this input
+	WaitClose   *time.Duration `config:"wait_close"`   //wait_close of the client publishing the events of this input
+}
+
+const (
+	ProtocolUDP      = "udp"
+	ProtocolTCP      = "tcp"
+	ProtocolUnixgram = "unixgram"
+	ProtocolUnix     = "unix"
+
+	DialectInfluxDB  = "influxdb"
+	DialectGraphite  = "graphite"
+	DialectDogStatsD = "dogstatsd"
+)
+
+// InitDefaults is called by the config unpacker for every input in the list.
+func (c *InputConfig) InitDefaults() {
+	c.Protocol = ProtocolUDP
+	c.Dialect = DialectInfluxDB
+}
+
+// Validate is called by the config unpacker.
+func (c *InputConfig) Validate() error {
+	switch c.Protocol {
+	case ProtocolUDP, ProtocolTCP, ProtocolUnixgram, ProtocolUnix:
+	default:
+		return fmt.Errorf("inputs.protocol must be udp, tcp, unixgram or unix but was %q", c.Protocol)
+	}
+	if len(c.Address) == 0 {
+		return fmt.Errorf("inputs.address is required for a %v input", c.Protocol)
+	}
+	switch c.Dialect {
+	case DialectInfluxDB, DialectGraphite, DialectDogStatsD:
+	default:
+		return fmt.Errorf("inputs.dialect must be influxdb, graphite or dogstatsd but was %q", c.Dialect)
+	}
+	if len(c.Mode) > 0 {
+		if _, err := strconv.ParseUint(c.Mode, 8, 32); err != nil {
+			return fmt.Errorf("inputs.mode must be octal but was %q", c.Mode)
+		}
+	}
+	return validatePublish("inputs.", c.PublishMode, c.WaitClose)
+}
+
+// ListenInputs returns the inputs, or when there are none the inputs of the
+// statsdserver, tcpserver and unixsocket settings.
+func (c *Config) ListenInputs() []InputConfig {
+	if len(c.Inputs) > 0 {
+		return c.Inputs
+	}
+	var inputs []InputConfig
+	if len(c.UDPAddress) > 0 {
+		inputs = append(inputs, InputConfig{Protocol: ProtocolUDP, Address: c.UDPAddress, Dialect: DialectInfluxDB})
+	}
+	if len(c.TCPAddress) > 0 {
+		inputs = append(inputs, InputConfig{Protocol: ProtocolTCP, Address: c.TCPAddress, Dialect: DialectInfluxDB})
+	}
+	if len(c.UnixSocket.Path) > 0 {
+		inputs = append(inputs, InputConfig{
+			Protocol: c.UnixSocket.Type,
+			Address:  c.UnixSocket.Path,
+			Dialect:  DialectInfluxDB,
+			Mode:     c.UnixSocket.Mode,
+			Owner:    c.UnixSocket.Owner,
+			Group:    c.UnixSocket.Group,
+		})
+	}
+	return inputs
+}
+
+// UnixSocketConfig is a unix socket for statsd messages.
+type UnixSocketConfig struct {
+	Path  string `config:"path"`  //the socket file, empty disables the socket
+	Type  string `config:"type"`  //"unixgram" for datagrams, "unix" for newline delimited streams
+	Mode  string `config:"mode"`  //octal file mode of the socket, e.g. "0660"
+	Owner string `config:"owner"` //user name or id that owns the socket
+	Group string `config:"group"` //group name or id of the socket
+}
+
+const (
+	UnixSocketDatagram = "unixgram"
+	UnixSocketStream   = "unix"
+)
+
+// Validate is called by the config unpacker.
+func (c *UnixSocketConfig) Validate() error {
+	if c.Type != UnixSocketDatagram && c.Type != UnixSocketStream {
+		return fmt.Errorf("unixsocket.type must be %q or %q but was %q", UnixSocketDatagram, UnixSocketStream, c.Type)
+	}
+	if len(c.Mode) > 0 {
+		if _, err := strconv.ParseUint(c.Mode, 8, 32); err != nil {
+			return fmt.Errorf("unixsocket.mode must be octal but was %q", c.Mode)
+		}
+	}
+	return nil
+}
+
+// SetConfig limits the memory a single set can use during one period.
+type SetConfig struct {
+	MaxMembers int    `config:"max_members"` //distinct members kept per set and period
+	Mode       string `config:"mode"`        //what happens beyond max_members: "exact" stops counting, "hyperloglog" estimates
+}
+
+const (
+	SetModeExact       = "exact"
+	SetModeHyperLogLog = "hyperloglog"
+)
+
+// Validate is called by the config unpacker.
+func (c *SetConfig) Validate() error {
+	if c.MaxMembers <= 0 {
+		return fmt.Errorf("sets.max_members must be positive but was %d", c.MaxMembers)
+	}
+	if c.Mode != SetModeExact && c.Mode != SetModeHyperLogLog {
+		return fmt.Errorf("sets.mode must be %q or %q but was %q", SetModeExact, SetModeHyperLogLog, c.Mode)
+	}
+	return nil
 }
 
 var DefaultConfig = Config{
 	Period:           5 * time.Second,
 	UDPAddress:       ":8125",
 	TCPHealthAddress: "",
+	MaxMessageSize:   8192,
+	ShutdownGrace:    time.Second,
+	Readers:          1,
+	PublishMode:      PublishModeGuaranteed,
+	WaitClose:        10 * time.Second,
+	UnixSocket: UnixSocketConfig{
+		Type: UnixSocketDatagram,
+	},
+	TCP: TCPConfig{
+		IdleTimeout:   5 * time.Minute,
+		MaxLineLength: 8192,
+	},
+	Lenient: true,
+	Buffer: BufferConfig{
+		MaxEvents: 100000,
+		Overflow:  OverflowDropNewest,
+	},
+	Spool: SpoolConfig{
+		Path:     "spool",
+		MaxBytes: 100 << 20,
+	},
+	SelfMetrics: SelfConfig{
+		Prefix: "statsd.",
+	},
+	Sets: SetConfig{
+		MaxMembers: 10000,
+		Mode:       SetModeHyperLogLog,
+	},
+	Aggregation: Aggregation{
+		Percentiles: []float64{90},
+	},
+	Gauges: GaugeConfig{
+		IdleTTL: time.Hour,
+	},
 }
@@ -2,3 +2,139 @@
 // +build !integration
 
 package config
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestListenInputs(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     map[string]interface{}
+		want    []InputConfig
+		wantErr bool
+	}{
+		{
+			name: "legacy settings",
+			cfg:  map[string]interface{}{"tcpserver": ":8127"},
+			want: []InputConfig{
+				{Protocol: ProtocolUDP, Address: ":8125", Dialect: DialectInfluxDB},
+				{Protocol: ProtocolTCP, Address: ":8127", Dialect: DialectInfluxDB},
+			},
+		},
+		{
+			name: "inputs replace the legacy settings",
+			cfg: map[string]interface{}{
+				"inputs": []map[string]interface{}{
+					{"address": ":9125"},
+					{"protocol": "tcp", "address": ":9127", "dialect": "dogstatsd", "prefix": "app.", "tags": map[string]string{"env": "prod"}},
+				},
+			},
+			want: []InputConfig{
+				{Protocol: ProtocolUDP, Address: ":9125", Dialect: DialectInfluxDB},
+				{Protocol: ProtocolTCP, Address: ":9127", Dialect: DialectDogStatsD, Prefix: "app.", Tags: map[string]string{"env": "prod"}},
+			},
+		},
+		{
+			name:    "unknown dialect",
+			cfg:     map[string]interface{}{"inputs": []map[string]interface{}{{"address": ":9125", "dialect": "carbon"}}},
+			wantErr: true,
+		},
+		{
+			name:    "missing address",
+			cfg:     map[string]interface{}{"inputs": []map[string]interface{}{{"protocol": "unix"}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := DefaultConfig
+			err := common.MustNewConfigFrom(tt.cfg).Unpack(&c)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unpack() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := c.ListenInputs(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListenInputs() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPublish(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     map[string]interface{}
+		bucket  string
+		want    Publish
+		wantErr bool
+	}{
+		{
+			name:   "global settings",
+			cfg:    map[string]interface{}{"publish_mode": "drop_if_full"},
+			bucket: "requests",
+			want:   Publish{Mode: PublishModeDropIfFull, WaitClose: 10 * time.Second},
+		},
+		{
+			name:   "input settings",
+			cfg:    map[string]interface{}{"inputs": []map[string]interface{}{{"address": ":9125", "publish_mode": "drop_if_full", "wait_close": "0s"}}},
+			bucket: "requests",
+			want:   Publish{Mode: PublishModeDropIfFull},
+		},
+		{
+			name: "matching rule",
+			cfg: map[string]interface{}{
+				"inputs":        []map[string]interface{}{{"address": ":9125", "publish_mode": "drop_if_full", "wait_close": "0s"}},
+				"publish_rules": []map[string]interface{}{{"buckets": []string{"billing.*", "orders.*"}, "publish_mode": "guaranteed"}},
+			},
+			bucket: "orders.created",
+			want:   Publish{Mode: PublishModeGuaranteed},
+		},
+		{
+			name: "no matching rule",
+			cfg: map[string]interface{}{
+				"publish_rules": []map[string]interface{}{{"buckets": []string{"orders.*"}, "wait_close": "1m"}},
+			},
+			bucket: "requests",
+			want:   Publish{Mode: PublishModeGuaranteed, WaitClose: 10 * time.Second},
+		},
+		{
+			name:    "unknown mode",
+			cfg:     map[string]interface{}{"inputs": []map[string]interface{}{{"address": ":9125", "publish_mode": "async"}}},
+			wantErr: true,
+		},
+		{
+			name:    "bad pattern",
+			cfg:     map[string]interface{}{"publish_rules": []map[string]interface{}{{"buckets": []string{"orders.["}}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := DefaultConfig
+			err := common.MustNewConfigFrom(tt.cfg).Unpack(&c)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unpack() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got := c.InputPublish(c.ListenInputs()[0])
+			for i := range c.PublishRules {
+				if c.PublishRules[i].Matches(tt.bucket) {
+					got = c.PublishRules[i].Apply(got)
+					break
+				}
+			}
+			if got != tt.want {
+				t.Errorf("publish of %v = %+v, want %+v", tt.bucket, got, tt.want)
+			}
+		})
+	}
+}
@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzsvftXIze2P/r7/BW6zFqXJscuzLNp7nfu/RIgCev0g2noyZykZ2G5SrY1lKWKpMI4557//bu0t6RSPUwD3TidhD5nCNhVemxJW/v52X8lPx69f3v29vv/i5xIIqQhLOOGmCnXZMxzRjKuWGryRY9wQ+ZUkwkTTFHDMjJaEDNl5PT4ghRK/pulpveXv5IR1SwjUsDnN0xpLgXZSrYGySD5y1/Jec6oZuSGa27I1JhCH25uTriZlqMklbNNllNteLrJUk2MJLqcTJg2JJ1SMWHwkW13zFme6eQvf+mTa7Y4JCzVfyHEcJOzQ/vAXwjJmE4VLwyXAj4i37l3iHv78C+EENIngs7YIVn/34bPmDZ0VqzDF4Tk7IblhySVirlPFPul5Iplh8So0n9oFgU7JBk1/oNaz+sn1LBN2zaZT5kAirEbJgyRik+4sJRM/uLevLRk5xoeysJ77NYomlqKj5WcVS30bNc8pXm+IIoVimkmDBcT6MgPLnTXuXZaliplof+zcfQCfkemVBMh/WhzEsjUw11yQ/OSwaDDYApZlLntxjXrOhtzpQ283xiWYinjN9WoCl6wnItqXO8d3XHlyFgqQvMcW9CJXy92S2eF3QDr24Ot/f5gr7+9czk4OBzsHe7sJgd7Oz+t15Y8pyOW66WLjesqR3Zju4/wjyv85pot5lJlnYt+XGojZ/aRTaRPQbnSYT7HVJARI6U9KUYSmmVkxgwlXIylmlHbiP3czY9cTGWZZ3A6UykM5YIIpu0y4oBgU9t/R3mO66EJVYxoIy3RqPZjDQM49aQaZjK9ZmpIqMjI8PpADx1ZOqj632u0KHKewvjWDsnaWMr+iKq1Hllj4sZ+UiiZlSl8/z91Ys+Y1nTCPkFtw25NJ0G/k4rkcuJIArvEtej2hCMMfmWfdF/3iCwMn/Ffw260u+eGs7k9KVwQCk/bD5gK9LHdaaPK1JSWgrmcaDLnZipLQ6ioDkNtDD0izZQpx15IioucSpFSw0R0Hoy0g5gRSqbljIq+YjSjo5wRXc5mVC2IjM5hfDhnZW54kYe5a8JuubaMYMoWVYezERcsI1wYSaQIT7eX9AeW55L8KFWe1RbL0MmnzkV99/OJkIpd0ZG8YYdka7C927WKr7k2dm7uXR0OgKETwmg69TNu7ryf442Fu2177V/1DUYnTPi94y6Co+ijiZJlcUi2O3fX5ZTh+2Ht3DFzjJgSOrJLjyxzbOb2dFlma+y9OHZtUrGwK0HtKc1zey57JGMGf5GKyJFm6sYuGm5iaTffVNr1k4oYes00mTGqS8Vm9gHPdPxjzdOrCRdpXmaMfMuo5RMwX01mdEForiVRpbBvu36VTuAehIkm37ipuib11DLUEat4N+x3O37Kc+13JBJJlULY0yORQHZs0fyUa3I+ZSrm9FNaFMzuSztZOL9hqnALWAKIsEfHUhohjV19P91DcoYdplaCkGOcNpxne0B71QgTuyWIE2FGjIYtZc/10fkbEGbcPVufkltzWhSbdjI8ZQmpdkfMnzPJPPGAMYOAQvgY9wvXxN7GxEyVLCdT8kvJStu+XmjDZprk/JqR/6Tja9oj71nGcYcUSqZMay4mflnc47pMp5aPv5YTbaieEpwHuQCCB6Lh0YSt7skYiTrxaRmVPM8Sz8fC113nfdmZv/Pct8/Y6a1hIrOXvO26Rsqx2xG4dn8JTVyiFGrZu5WLhGvAyHA+qVh0tAdnkOJCoBQTmrRno1DyhmesZ8UaXbCUj3lK8G0Qn7gOgl6gbI0fzZhRPLX7Kgi4L5P9ZEBe0Fm2v7vRIzkfwdf48c/7dHuHHYwPxjuD8d5gsDWiO7u7bJft7WYH2at0dLCdjrYGL9OoMwLzMmR7sD3oD7b7gz2yvXO4NTjcGpD/GAwGA/Lh8vhfNWqPaZmbK6DXIRnTXLPWsrNiymZM0fyKZ+1FZ26JvvDC+z4JzyzPHHOmkJ9w7c7VCz6GiwpuM73R3ALcyj5qBrKlVwRoqqS2C6UNVZbBjkpDhriDeDaE42kPZvcKHtBduxDjFoG6yPLlz8IHwX+xQvPD6REEN8vJkP/Be3OQEEeMALfjSzaum3bWmrb9uYqJO7kY2HN8pbRWXBOKT+F9ipLNhN8wEIypcK/h0+7rKcuLcZlbHmw5ipt1aNjMJfnO3QeEC22oSJ2g3LjQtO0YbjW7qZyURiopjRVUAacJbXNNBGMZKr7zKU+n7a7CxZDKme3MKnPRvM/Glh/5iwumijea/0iODRMkZ2ND2Kwwi+4lHkvZWl27cKtY3ctFccey+kvUdkRoPqcLTbSxPwPNrRKip34r43I7nRDftQJjUpFMBFEgULt6Fo+E62jEqkdANuLj2oaoVrK5MWqbYkbTqVVMu0nfbMvT310SK1iCf7jrqL4IHWPdTwbJoK/S7boMrRsCdGmkkDNZanIBksg9hOkjQWj1Ggow5MXRxQYeaicau0GmUggGpo0zYZgSzJBzJY1MZSWTvDg73yBKlnA3F4qN+S3TpBQZQ6nBygJK5rY5yzOlIjOpGBHMzKW6JrJgihqprLwd2hyxKc3H9hVKrLiVM0KzGRdcG3uyb7x0b1vL5AxVAWqIM7LgRGYzKXokzRlV+eIv1XqMQfsKI5Y5Txeg5UwZCDF2msmDJTZRzkZBtib3uLRzGcTI1iK5CwjbJDTPZQoyvxtlawGdEBw+rgkqbo1dYy+OLt5ukBI6yBfVHadRwwvLgqfqrEGP2ibd2tvaf9UihFQTKvivwICT7ovrSwkyoG9fNVckHkxksKj31PGFlWF0UzILm2a58NZYu3fR/GEUnbT7Xkq7q1+/Pq6d8DTnLTX5OP7sTj35yL1vj7Lf51S7jc0Nt+cMD5VfZnfAnZTvFXvUfxWbUJWBVmSVHil0L3oeNaIRRwM0l4LmZJzLOVEslSrTNVvN5fG5axXvzGqYrbHZD+zj0cjgaGsmgjZsn7n4r7ekoOk1My/0RgK9oHmncAyq1RVaV62QWuvUq/EKtAqm7TicmumpZBQVmsJgEnIhZywofqVGFdowNSNr3mQs1VplSlJs7DmhG4poTFDj8XVfOxMHruyIBRUfTBwRAdyxtsMSE7/MVRfx+NFs4zaS78Den6UuLUFcq5VtgQs7vH+XAhcATA1oPPCm/Y7GKvoKaVpNWpEP16sPXMBbT4PNFdvb9P0EizkcJBQiaZYRzWZUGJ7CvcJujZM32S1qHj0U77zdSwep00hyw+10+a+sshvZiTIFuqrmpqRuOc7GZCFLFfoY0zxYov1dYznyRKpFzz7qxSJteJ4TJnSpnGzszPRWdMqYNnZ7WJJago15ngcmSItCyUJxali+eITFgGaZYlqvSmuEU4CGI7fn3ACcZBbYz2zEJ6Usdb7AXQ7vhGbnllxazhi4LUjONRhwz857hPqbXSpC7YV1S7S0+ych5L8qigdJNZbV7PoqOvdj8udhmLgPhkjGuvwrCDeReJuVaEzHa3eY8GJohzJMcFjDHslYwUTmFBPUKqSoBgE2rEgUraS45FlYqGjxLC88Ql6IxzxaGNZx7juVl8Y+Qqtau4naAL+1X6K5NDg43Zl3WwxZdveyH+y2BowHaAXqlrtTsL+kNY4Jk0nKzeJqReaVY6uxLF3hN1ZPYpGxtzZMKQwXTJirVGarGOvlXPZzZgyzl2DG6g7sMJp1vXw+b49qbd/D+lmf6IoW5W1k6wqdd09IKjMlRzOmeEqXDL4URi2uuJarWqdj7JKcXbyDheoc+fHRncNd1fZ3Q126Y46poFk3ZeFOu7+NZsLkVSG5MMvG8lqKCTdlhoJZTg380Tmq9f8mazk41Psvd5L9rd2DnUGPrOXUrB2S3b1kb7D3auuA/E/7orcDf9oLrWG21kz1veAVfYUqnydhjzgzHIrhckwmiooyp4qbRSxBLUhqJTnQOyJJ6dgLSMH4iaeHKxSpU2bFAKd9jXMplZMmemDUm/JKt6muHBxeTorpQnP7i/fepp5n6mgIb6WJwlnAS83RrDUDyWfCpJ9ttylwJLWRop+lnWtWSG1ovqoTvH4O3SGbpVrLlFf+XYyWcFOpCPAPF0RS6QzOSRcccsHJPGLkWsi5sBoiJXZq0JFU5Kezc1Kboz0KIJDfULUgc55ZmQ+ufMcp0LUHv3bT9dXuYHfwGLav2IRLsUrG+R56/BTf7P+9LW9F410R53RjXco4/16yEevey1av+jXWSp5QZJgyjB77Fey849rm7QUf+dnR26PouaWTchft5pGagNhBN78tmZD66oirhrB6j03Gi3tSoPZgbX5n50Gf9PICypcvzs5vdu2JOju/2d9oy5kzmq6Cj7w5Ou4eYMPNI6QJfv0ZdUL9+++OycvB7jZERmBQJ8sOyalV82RqmCEvwJzBdY8c9Ee8ulmt7rCBTnknIrpIwbkkP5dFwVRKNfsXmbJbmrGUz2hOMj7hBrxoVpy0I4WwuNCmGz52bBmXIKXQfOLCptiEqYRclClEY9y4B11QHXr/cAyVMDFdFFO25DYYDPqDQX/vFH7u9Ld3WisoqEm6dtDSe3z5Llq/VFRotImdnduZOgsRBua+PboM5lbygiWTxPkk7A0RG4LBtujdFTWXfLgYIwsjMYqCm0tMSC5pRkY0pyKFe3rMFZvTPEeLrpKlvb477BaWCIVU5vFmC69uaqP4cntGTCHb3++JRmjhfKQ2XqPCObb0aL17uz22zrV7iJng7nU7d2sVM57mGOy9qQ1TLLtaZgV4GtnYMr4pn0yZNtEgPD1xLD2YYFGwzE9DlyNvPAgtf1fFLqCMGzXnLJZWFlsbS5m455JUztYsi1yLP2gGVWAksguWyJhhagaSfKFYyrWVxUAkpGglhQg1iMYuRzlPiS7HY34bWoRnXkyNKQ43N/ERfCKRarKRkEu1ANYrUYi85VZyRgFytCCaz4p8QQy9jtcb7nOqDbB2DENGeVFIQ8AQOGd5DrO/fH1SRcWtpTIpr9e6mW9EkdZuCeRf5S4JncKBCerTuLTs4peS5nzMq6XG6A+M84zUmTz3Wwh0F8JuU1aYKhwTXqt85K1jkUD0BCUFVYZHLhrSGgEwJI592f+571HqqnQ8UMZKu1a255SKykdD6vutF1EgRG23JjRiuZx3b//us1I/TzFt1+bzecKoNsls4VrADYMnhmqzFsWU4CBcK1Oqq6BrmCuIPqGbSupc0+VoO9HlaKt2KHu1zV0ND5Uo5wzw0YxVG2s9PItC2kuD5xBIwBSXSwK77CQeKrEaWVzBlH4DTsnGY3sp3jA7CreJHGVesMvXJxs9VC6DZlmtSWgX2U3PO3mBcdjt7PdRdICSNlNt9huajULH7ArCHvl9c1PgpMsYabUS92ep8F1rP5WaqWS1Wym2rWKcgFTofbeDwYCjGQNPkxwvu2KpIK9Pjs4hpBopcRKaivdQWxiDGbMZ5R0B1E8y4Q92VtChV8TaEhAMynLiJcaDP7SvyxJoXVcXEZgE6Q3lOR3lS8wA+YgpQ0650IZFW7dGT3CQfzWbG0azmt2NE19ZsHg7MNrnBuCcfSwmOJE3i5waqzosOQQ49hUayOMVw867BzalerqqXeQoCvzQ9osmVqWY1X9bWRjUMU5BqJBiEafNoZYWbbMPmrlo6yHMimcYvwB/2NkOg2CTSjHGNaV5rU8qsg5ZEaJ8l2zIlQToL4nPRzIuWdSnM9V+lZz2Ymq1cXTPQP4VF91EilgtBVbbTT4l864ggyc5FEdKUUiyhM0OPXufFdiN6+mXHRNZ/3ntmo+ooFcQNbzWI2uKgcYhJle2UZ+c+Am6VlGZsgzJoT4os/rorphMzGoneJ5DTA40CIqbGCsaMlmraaEtEzMRqgMOGQnu9878uzF5U+U+cR0nTlBBTo+3Ufu0x3rMTDplGnx2f6naJ9xol/RYDdSyhHoGby3pkusQeF8fgmtXlcJlUyo2kyaE6RNZGs0zFvXUHBmOiRKX7Ocn5Bp2YV3wqvM31pONsdGqIchrdJ17A5ptlutqqI5gjwnBS8E5trrreP2yIhz2DXmecUARz0IWr2OXC5Lx8Zip2EwK3lYOGatWaLEMrW+YoMIQJm64kmJW9yNUe+7ox4vQOc96PlAJzgd59/57cpZhdi0E55ZNzt2twezv7798+fLg4ODVq46YMUfmVbrW24T2rJXmnOo7aBxoG9r+PBqj2NyicsZ1kdNFU1iMbRCI3NHP2M1DTRFOQuc5N4urbm/i01wEUb/oLeQ+0BK4DjCzis113gWl7jOqTX+r7R/1uUSrO7BnPtfs7MTfYjAHzz67JsD7W9s7u3v7Lw9eDegozdh4sHwmKzwTYS5xtmD3bCJHKHzRnfD2JKN847l9lPv2SZKb7WTGMl62LdIOSOc3YfGu75h5djGLGms4D+/0yNGvVsSoPlmSPL3ou44eyiU8bX4bvuypg/EE96ULctM6ZZaz0NmCPJ42Nzx7UMj3Fwtq9OSBASSeIjEQDZ3rHqGWCD0ySYteTe+QCt31NJcpo6Jbc5jr1pQxRGZFE3YRMp9xPbTG7/Elfpvt7CVTn10coytkXFtNpuR66p/TDckYYEkqGcRbYhAlBwQQvyF6hE1AwLDawY0mr+lslNEe+f74nHx/fEpuqpU9KgpyKiZchCPzjzf2Ffu5Q7roOni0KAhzr9nf3ZB7bqaqFD0ypmpCDeuRHLrvPn743YMUN5mxK80nglr1qqHByYyRi8Z3d6lyl1OmWROBpmYtAX1oxAVVCwznC13rhyeUIrjAPU0WIylzRsWyjfUtfg3GJVqAqskxt9aNz24xF73TrVEbVbJ76cy1I8Qndk+uEMzCHqDILOTFcjsQdO8ggksHnkFLgnc4Mx5Qi8yoKMfUIU6NFpZ6HmroholMRjaVy6CJQlhUzm4oBm0cFZbzfvPugkiRL4mhTOUssf2y5LZIk0LJ28WD6W6oKVeWCnaUZdwlgLZPA9xATBl0STM3tG76j8vcowVNIM1ALQojJ4oWU54SppRUugqLjVu9oTnP4vBlqYhRpTa+P/Ka0RtGShHlOI594Bm8Wr3i782q/dDs3Kp6Ip2y9HoZ+Mzp+/fv3l99eHv5/sPF5enJ1ft37y4fvH4lAtmtKLz0ArurCfKBfdVklipZhadK2v1PjqUqpGoG59/Pw83obMW8wXb5JRkEtCeV4wAOsMCzBQeKllT8oLIBPJwvnP79h3/+dPDm4OgfD6az3dbsIXT+xHWyfmGkYmgJjI9Zx9Eh6bQeEvIPe96o8XGfy44cvgdh7IiZ55Mxe2AjtPJdaLIWrGEJWAdKGy2IkTLXDncKvGyAVMTSa7QCIo/opPrjLj5gKl+Q3t33N3oeQTSv3+Q3TGGIEJ1QLqLQE/tGkE+sRBnbmzpZJK0tyj343/0JVglpIEoFRhPks/rHdyIfhIfr2e0u77wF3hnBBTqAMddqGAtx0c6kgmG1uzRqJEKFjeTCKcuLyIEHpmeMWg1Na2fUFgsr+xoeaZv3lw5X6WeriMKztlGHz+hkpVpSrNxC5yF3DwdpNymCs8mOpE8crqGTFY222plurHTSERVSw7q9z5BquLfkbuzb1pjOYCQOSLY1lhUuZUWcehpIsCHg+ViVEQF7s5I+neAFxXW1sToVI8TnrfEze+avtFGMzuoc7cQyg4v4i0+inkZteQ5j6DXDQDouEGbB36WCzR2oYtyHoABnptMpiyxjZ6LrlfrDVWJGiHOJHg2KMMDZ+pnKZlqgvXBLmtdedX67scxzCTi/MyoEU4dk+N/RhMFM/D/92kf2d81M41MISS1oyv5nmASmzAGt1fkrI8xfEBBC5MOUAna38tKBchoBodpHK1Z0ZCDhRDPRCXkjVQOjxW0bjDwcy1JkOGGuA6A6RLyiPyZJ5eYol5NNKvpcmICj2zeyb6asH9w21NA+9trHVerjKv1s33ZjLKQ2/wprfCTIKb6tGVXptLYGqRSaa6ObuFwjml4j0mrGU6ZR3PJGl8ZWgazDma6FdTbed7mb5KRkuDnwRN1YGVqKXrtdTWZWIoTwUtwgtil267emYtoo7nFzarFUnXufaRfBEtB4hx+HPTLctD++sT/+P/tjzf74X/bH/2t//P/2BxmSF7Ctqm2y4Uc87A3BrTn86zDxoPma4ZGpEx3wf5hIMUWTVsryks0wKXnGNpnwUPvYzGZoZjMtlWLCbDoK91PFqGF9oFIyNbP8r41vaMH7BTXTfkEVnemfYxL+6xGyhzuED+DKdrMZKszVJy6mtcpyYs9PBCNrpsjlqCEzQGLWTGjmVTenjn0M7X6MZDbPuJKPooXMPBQTLm4TCplkds0LJWfMTFkJfzGRQRr5MG6ZmRQ3Xm3XwtDAyT3nIA4axDyFzzOs3TC1ArajHtHMxK3OWQCTQpb7cQ0AlXj6cS1E2fl34YmEDDEExn06dCpQ3Cr0GOARsWGqybCDpw6Tj+JbtpCgXTU2cdxkx3WRKm6Y4tRO0mod9qrFeIxhGBv2PaU6OgJxs/GmPPwoCPmGvLGMIEbVH/aH+M1bCSlKKKYLsjUYRJx8reuejtf5odpcuF6eYscfYZSTT9sPfSUg58KvQZtxOFYUuCKcVC4mMRHd7ZR8FG+semFb1oTmitFs4eNCmEvW9wwa8WnpwivWuMtqR6lLNhBy7n0Iro0R04YUdhF4yjDd3JE3IXY4cZM4Moh59+pmnL0ORr6he3uYOPDVUMrCKnaAEQ64XHG79vYBuKbw7vJNXb9X6ns4btNt52FYmngzg4RT8fQ7dnHc5BfY0BXi/oPNQQ/zvt93J68fCSJvmLKkBV69KFiNcbl9FENJ4E2WL3BLsyx2Sq7lcqLXYFOuIaK3XkvIj4yw24KliPtmhQSaZWTNKHtOKkLBW3ohzJTZ9V6rEPGoIuPSlGqJi9d2+hDjRYTp1hD2W1/cKexHj1fCKlp6msIl8wVZ6liOf/EzQSC8TUxFTermCTBLVph8GFxXgwnsuRxWK924JjF0y4G94c61CxzVHqCdUIl+oTvmFoEPfgp2EO/xcagORJyDZQmwXtQBiLgZZKahZKqhbE0MY4zoca7VkYfkA8OXqM9YL+vQk6FOTL8ZYuTIWvke2LuhbW+Sdr6mOr4AjDcu3QAhsXDBiu7nPBXCLBEIJFD6d4UvWINNvBfIYGjV1TD6QiCDoVkAG3wGGXwGGfzdpAP8LkEG44PvEQ9cQbSOwf+mSIPxFfcMN/jwsT7DDbYn9Aw3+Aw3+Aw3+Aw3+Aw3+Aw3+Aw32Brlnw9uMJaHv17MwWiUz8CDXynwIC/AVRDtp08g67EapF6h+I29BE7e/LTRBaoHVyRcKF891iCA1kUxXm72EPlV0ctIu6iWOicMkkKeZtZPjR74QIX6t4UQrPGR5kC+NhzBrKX/P4MJPoMJPoMJPoMJPoMJPoMJPoMJPoMJPnjCz2CCz2CCz2CCz2CCz2CCNco9gwk+gwn+rsEEs9xLED5S8PVr98Gn0oHuAz8B7pacjxRVnGmSLQSdoYHKtZpLmqE1U/psZ/B2ua8hcBiLskO0ryt07KoYS7KmpxQqJ9T6WUMBuEIcAcXOK0QjnxTjNCFmsD3topmDTukTgg79aL4hJziBfs7FtetvQV4MkyzPhxuuyrs3vklBfuQik3NdvX+Bw32HKe4vhomWXe99EPy2D4J7a+6tsdSGscj5qKvBGU3fXTw8zK6OWJL8AUBAGjN6xgT5bTBBmsvwDBHyu4YIaS7nnwMxpDHrZwCR1QCINMn+jCeyYjyRxgI8w4s8kH5WGU5m2d6KOMWbkz3s8lHj1FO6taKBXvxwtPV5I93e21/dWLf39j9vtHuRT/vJR7u3tf05o9UZYw+J/Pis0V6cnJ6eP260KxI5aiZSp4A1L1lImIZYjBktdBcYxZjnDKEx9XU3g7lmSrB8Zzup9PR7k6KgZlW2vu/KPMfZ2E5bdOmY2PHhR6ccf7wAxXZn++NnTZYlkOppWBoBaD71vI/PP5C4W2KomjATTMeWJJ3Tv93ffcQMraBAxWJFkzsLVUyx29bW7fmU64xQA0/xnPUBQurJZO6CJdEgV02JRlz5IwlxTpvB+febuO3qCmrCrn7mrtvPmPV+spO82h8Mkq2Xu1t7j5g+nxWrdF8codMiQHsVUhlXkuL8FE82ORLEjYr0+xCtAo+R2jj7fe+r9zrXmIsJU4XiwgH0QnrhDROEjg1TRDGkpku/9eUqrNzdhzlXMq6iQgcThkaEDZkCKEvWcxmacwz1gERshNUxilbAL3b0mOlel4+VwIepqYHDjLlibAHMCKGCzFQxavqKOWyY7cHW7uZga9MoBN/pz2huFcg+EqdvO+RiAuAwSyJR0/2DwU66y15tb2/ZX7KU7r3a36E029nPsvEjNo9UfMIFza/gEK3QMxdO0Odyz4vzo7O3l8npP08fMX2nu696zq7bz537Wrg6Pt4enXqrOvz+LtjHUYxYuw9xgvNDeDXbOz/eXrgP7nR+uDJKLpPHdn7y9oL8UjI4yABRJfScqepA2e9dKSWnyTMOZzpEi4PpW0xyFtpakEJxCW6OCTMwR9esa/TFMBMacMkO4fnhBkG5Y+E7iVuHyASPx4DuT+e2MSFHHbsNEA8ag3VoLUjOjQHtDXOGhiFcy5CGA+20R4mvDjcek/Bfm/l9NnALk7CFD0LBGRdhSFDh3sL4JJpOXb9EY1V2opgplYh6G6HhTDcB5y+hthMX9hw5WlV59n5RkPaauV7rMAKjBTk9vqjOw3uWSpW5toDPA3eOLdizajr4pe9ckLl96/T4wjXfTCiz62v3HSKjQBQ25CUw+KaO+WGf8/ubHBky44LPylnPfRja9ZMCBLVoryEM09AODlAoWtPgugqc6VnlKTQJMZEpXMocLI12RlSTQmrNRxh0kgEGi5VXIyQcj1Yooy3cGijVJC21kR5NsAvIwM07zenK0CewDAPFnJawSB4IsoLzU8xlDSjYKZ2c9Ozt0ilFpdeeekYBTDJmoRhw6zMA6geJUayD5lMo8dWCiUz7wBwA+AGu5kkVN+hp0ilubA0S//9LqbPKVNbLegip3bVRHZHGlEjBFAQyRzQ7A1MmmJjlmBy/PXpzSgASy0EWyvzGSocRg1tf1wgpNYzYlImwSyTUqIPLWimmC2lJH1xbUSNwthNyFvidkMaHiTbbdDIZGf5SMh3AMIb22mI1YJjackFM9R2h9X7JjHlIcORd+SshuQ/Sj27Ab2ivBCACUKVzZbx5n6bTWoeEjNgYmF4NfIXrlKqMZQn5iSnpwadmYAqfuiAR5M8VYUf1QBrsagkgxfLNvcIKWJfTqvrVZ/Ax2NOt+UwZzZi6Gud0sjpnso/o2SYOMsKyaBwJgZHUytAULDU1ZLFDcnTUI5fHPfL+pEfeH/XI0UmPHJ/0yMm7JU6Hn9fen6z1yNr7ozjY5y4s7idbSjtXzBOL3ZBUuyRFJx0VSk4UneE2RrOoaZwIzO9gCiGT4sYAorXgFboPsh+9xMqwvbXVrp4kiyWZ1U9KGBe7IwV6o1EIRIR351685gISslDuroni+G/GtKYTlsSB/1xDzJKjrWOixrtrsSkU74FqEFrVbPdOGv79w+n7/2rRMPDo30z2UU4CxnsM1a17izm1K2aVNzlc4Y0hxzd1cAo0qsUKKfpgIrJicAwN/QITlHa2ASbMjoJsbe9vxPk+UtfeqC6ZODmZasJ0Sgt7TqlmZGvgk4o1efHx5OQkSuT+lqbXROdUT52C+0spASIptOyaSsglHekeSalSnE6Y05gccnPOo2T8MWNZ3AIAMSuX4PrR9MhHhW99FLBfmfNtP04qCOv/1SVrPidofo0JmmG//MaZmrxmfHEzvyu9ssVcfmcJhfP5fPliPGcOPmcOfsnMwWpj/TYqkNMUPy3RHB0dtXHMvCp/9aVAOY5aFtE8J2fnVuBkUM90GFuLhg0zjf9y6C2rbp/x8ZinZQ4Gu1KzHhmxlJY6eARuqOLMLLxKGO/qGTXaqskR/n5CTm8N4HqH8UVArH6gZsoUQ+xtoZOIUMPQPCL3cxOshxAOCSjcZspmANcTNY2yCL4E3zOqOWQhhBZvuC5pzn9lTkSykvhYLqkqv/7zWmR4svpc9edWl2LnZfbfQpXxfS+Hi3r7DgKJW6Ne4cFaj09W8Lj4IMKs51bESs2wUetX5UKWKqrTEHlsIOhywm+Ytg/Fvp4efBDHZmKRi9BuJnRoZYxjazpt7juKagDeA+P8M7VBNPqXwuMWF0y5+b+QBVrF84VtQksZbiunh+Ix2kjIkcgIdZau0GYLetsewuWeI+9nsdqpYx6d5yEyyoeis2nDP3d6fB//3BtmaD92KPiyas5j8PDqt0uDLhqBZYr9UnLFMqhy+IUjzk6PL0IUBlyiYR2w7I2RCRmyVCfuoSGm6fohVVwVxDXgZaU2WNgXwhry3G21aEf+OGUC1xYWOlVSR1KkL9rQ7zsDtXNA2QFBGHrOJ1OTL6pMrMoSVs0G3o8SsnJmsML0RLmoBpr92w7VgwSlUzajrTWJU+eWbLGtZJAM6jtMKdmo9HcaffSAFDgqIm+rS/2ALb8AK0+g6QcsqzJDQQafcy69omAAqp0zMmYG6xZ45gGZsSm1V9wcr7Zg0cF9wI1m+TiCNRHY+iN8rSsCIQRCo2ms4QbCgd/LsrmCBFwfzLdkVM5Ed8+hNdJiOwjiTX6tjrSh6fUV1JZ4ugv1q8z4vcQ0hhQKOvgySpYDAsG4IEUOvmV2W0sVBFnuC9Ud+VICf9hIvVhpw/wQsHPGoTIIrVSDHIu42b/pDU1yKibJ2zLPzyU4p079K3U2d1PxXs/moo8+WfgPWUxXMVPIrLg1SxLpqlpwWPdU8bTGrqricPZRAui4roiCbtW8aFT5gBIKVR0uZJ+V9vRaBuYJ95yvsRVSrakJXlXQSsWkaoOE0lxyHE3Cteeboj69ym5OyMAmdvYAg9WLqpA4BwkqeQEDz7Xpwy1AT46R23pYBcI3kkohXBDKiJm5VXNoXGqD1otyYGdccIOAunapcqnt3I78Snya3FiKzzUJcRqiRGTSnMwY1aViMyCJL9bUpmz0GGTUGHrNwn6OyRxvj4rGMzaTEAnFtG3GN5dVlHYlUG54YIuGzcDzUiqWkAtXBs9VJ7N38dAVqsRgEydN+Qifelm8cCLiABg3UihwYqhpyRwPiGpqlkx6snyFS6x9Cc4opw95D5IDG6hbigJKNUa/xtE5InqLnEFZYtgalZQ9pcLTO6WGTSSoOb79sOiWkQyBTH2aZa7enT1PfThPDD4a85y50oHZEJ2D3g0WWrTXDKgzUeyUC9rNYeexJTp4qZnqF1RrS9g+hsa1xSE3hdUsE2ZnOhDhsdUGrah8jGPwNTMwABEtEiCEU4MrVRkaQWdz1kK3ZLYhPxky5VgcK05baa5ZJdHiNlgb8QkZlQDmuWbHF7XIWaMAZqRw5IYpxx0bXRy6FR+ShbtcghZCACjGWRDdY6FNyHPkZuEcoyGVHXhcvsB3XRkb7NEuztBHRPv8VxqDsOty5IfVPA2hfa/Nun7BPokF8AolrYqd1hfK3VNuSpGZk2K5OLs0QZ2KKNmtIZdmaqXSRjWAu8X2p7G0nNUrRIEQH6I/XeUujqVcETQiomhUTMlHGpaahcsHKvhFeclOHtakFFFlpR5RbEJVlse7Am4ReJpYuai0v0hF7DRBfQU1Ei8secMU3FYQxO/FMC9Y8nipmA84RpmJnJ10L8/u/u5Be1GQc92Td2Sx7aZJc3dysMFK0XNhQWwT7t65r8Llik1Ru3lVlBWrGEVwCEHoBNZJKvs3GJ4KXkBRsqX7P+NWPkkdiu//hhJWhs4KZDHUxB9VRQrcWGs0hdEytOT64m9RPEPeupbOBJnZ615zU6JdoOeiZ81cktCtO5Qj1mF9wOvD/xmpUY38jJTmqa8CaZXTHAK5UOiKDXQulMWFDuO2rxhKLBLBssCrQHRckwB4kxFuHEdpjGQmBTeyClWtmlhfB6uAXzH7Jx3xnBsoVHDNWEHKAt058FJ84OpUTamr9Nqgo72e8RSmNO/FK1v57Bt4WbF5e3uwtd8f7PW3dy4HB4eDvcOd3eRg7+VPbcP20gq+T5u26bptxE2KGqXQ9QVRD5g4M4Wa3ZU+Z9UWqfyVhSDljbquuZz0nKqay8lGL+48RmJA+WlRlVWLznEqZxFGlT0s8bBhM0DS0Ax4PUAcCWmC8Q+atzJVrW9QNUMM50xmZV6RGzEMEQPJw8lk0kR1/uJmllxYBU2nrFnnNix72UoBfASEd0crXBSlufIPCCqki9WMnpGliR+i+g3Pc770OXSQwh7aWrq5TtxQaqZIAu7cMIT6bkMehytj+QX+zaw6p3zpElM5bmuhuV18zDMp6F1kXkWx6847kRaZeEi04F3XUzX01s3UvJRwj9qL2X/uxbmajcjeXeALliNQbbO2SWyF+YA/UD0lLwqmprTQUJjV2E+iVL4NcOjSubstDZSfoehXrE0sYzMptFGWNGD2AFu3lWa7DtHW9s7u3v7Lg1eDrt+Ovj0++U0NpWcndqZeXfwEet0B3R3vDQZZe8QCS218CTnpMtxTsL8CR6dK8RsfZ8ygVJOiuS8GLFVL6gF5x0PIgYAyrC7BWJdo7G8vwuSLkIqZOC5dSQe5lq3WaxJe3MGMOWQdD4iCiXRWhrADimQOJ19oOu/U7c+EU5btKUUjh1UjtS6h7LSQxM4NtLVekF6cPOA9jFMlhczlpIbjZ685ee1DRrg+rNGK/K/m5KpP/NIP7y1H7CVbg62fHgy7cs27mN1XrMP7YMFHKfFo6EKnsW2o71tp2mkhM8yLM/HXplW61nN2jOiyLzubZuRfNa6UYPB5V7aiTuuAi4T3mhfyTlQzJiXXU0JzpowXsOCc1CyFjRgUvCjrrTVkapwjmSLIv8YobBhBLUMzulTJlIosh+DWKVuAl3NOlbJnsjrCitk5g+G2+hBFHyCIknk1a26qImVTlhcY0acNVCGbMkgNDfkeqZyhMZdQAx7dSZlTFZJRKvVXWaFviSiWd+GfNmS+FQne2GuUygUh/jDHpmTrAiKcGgSKFvK3ssB8fLfdoASFVf+hadSM8nICUkjbelRFZFA4IcJL+yi/H4HYCnf8Rs+fJ2x52IhzrKm0lesGTKL2+bvk4tZq+HtjFevx3l4Q7NYEg4nd/sJwFU7rB3dc7pBWlhgJrOYBvjeGEmgm06uolH7GtZWKMjAeIwQzqOuAHsCy6pBYLcbFfkHoulGc3XhbwfAK12wIOaSlZgC1iCjT8oYpxTO3xWjE9n14lx9uL5RlJ6X27uc5z7OUqgw3pyV29zJesIJsvSKDg8Pt/cOtAXojjk+/Oxz833/d2t79fy5YWlpC4V8EcRJmVNAJU/jZVuIe3Rq4X+oSsOVTGut7YZEWbWRRsMy/hP/VKv3b1iCx/7dFMm3+tp1sJdvJti7M37a2d7ZrqtEdLlFZGqtn/q7uSKudPvaKdPMd+ljUjAlIaogZMF58kR2c+gUB91ylklOeW8Es2LEKpnzKQrgGoawn2skQGYFlnVLaW2lcuhBKsB4ZIKrfTyIfTVazIiNzw+zOxv1trxwPeBddUtUV3CBMz95fzniKVzuvTFHRBKOhH9kbTYTxe1mbYrQn3GWFLL2qS16EueHfLsUUxYzQaBW2jlKnmyOYUioc5yq9PmD6BeMGigW29eha1yE2EO8RyzZonscLfK9lvYkDm9zCxsGF35UK9lNFFuFS7t1FBBZLSOm3AnxVBdWtwxLJydSYboU2ZhuvSDBueN7tzvC9mmnsHTm0gtGwF4sTVCyC5AX6OoeMYT8wkkmG7H5Gr6vV0UzoJSzTkbbFehyEhFqV/+IiRFh2nT+088NpQynDR5hfLLQz+LXdAq/lJDJ5z1AWrMkHVVin10n9FdhRUbjCXV2SNVlxJ5RaLhZ6ZoXPqTFFtgFmfcRvLUcIHR7KSTYgxUOLLxCArVchdfXdFPv+KusflVZrFJONu/DqWsurGNUrw6xafw+9kfl0EQdK+uCONlNrW8U7XOC2NaAn1C1LQQJ3rNhS2h2I4FuveclDuz9C6J47c/j2sM6DXJOB3zhfmnsF6TjsqCGNk3UIsHbyIdSiUZSFzNmIAGa3y/UQjfFETdrTnjHB3TWlGKRLVJpLuEsawwtst7HudpC4WYejXKbXLCOaGzZcspkuIT0FuBhUPmU+e7opz9/TAAAo/zV76ZNvRNch+fD+Ncm5uPYJMXcjkPs929yRvhWsVADBJzyNg1lCYBwyl6NIde4FAaoGXhNZCw5BR7SXvmJ4U8+kANcsXN/1GtvdK+ZxwpCxxNljm9DP5l8HAzBePnjpuL6+0g059C7pdJxLujTQ8j3X1wRaA0VRcak45p80map2fI9omZdgLdtIaiL6B82cSxCmDE4558BEmcOe+I6KoWE+V0Kq2QM25J0TW38Lxj3+K8ugi09MsodRVDql4PsOrQ7s/toaDDoMojPKXV0JV4FnIUvYI3X3mLtxkCMBGoCOBqTrXlLbxNwZODWze09U00AqushzkM6wDkaHM0Nb/vSw4/24Mr0XriNX23+ZGAFhq41HISYf5+Rdi+Bc062giB74f+l1HTOF3dLUEKkyF1ETjFZRdEQcG+HHVjlvgyurk4I3TNX9MF+8qi4mxWMYYeiwfvZqF/Rd3usfAy5KUGZCizF+SpSJhk95R5kPQIltBJ7j6cR5VsvCCwpRQFlYHQhLdL1yZ+IAo4I2sV7gdnBsxwTRw0p8naBHTtYM8xkxS25AoBzmcpJo+D7x3yepzNgw8Qzdf1xd57EbocpUQPAy10VLMKq5wpEj+urR1RE+O7nYSHyyce2NoB64rU640UTORegRU5esPFHlJIV2U1lgGN/y6UbxXWHC3TfTy/ZeN/RBFb0/7fxET+sn3Z8uuDF2gNauFO8MrYJ37vCA2nP9qxQrTLG7W+GuTdUenorx2N0Q2kUboQvJdnOoy0K5YjTz8qITEvyhqLxS0XWMh9VvJMRMnXNds06kKSsQUCN06rNMAYuHWlYhBaixZyeu87XTUsmCbR7NtGEqo7O1CGyDjkaK3aC+7h+/uFzbQPWZ/PDD4WxWMR5Oc/9Uf7B3OBisbXSw4e7chK/YCmemXD0y2BTiMusGtkYM5ZouR32MOl0DiaKH2wwjOGt3UFA8WpGsuGXxTugRJuwe0FFoquPLGURcyMiwh5OCDPZC2WUGQdgZrXzCr6sLv8Qe/qRBo85utihYl0hUqlUViF1vqjgC+gEYWC8NSmCOXJT2qN8wbfjEz7hu1bqH9iMQE9k1jTlxXPQzVphpq3W85pyXsjJwoXNexBlFLmNYgPJMipymbKketUR/qljDZ+tRs0WHJgXdbO5tv9zKWDbqj/dGg/7u9tZB/+DleNDfpenuwcsB3TkYs/toWX6njHlwBLoMoe+qT+5MEDpC5P9GNglgVLU8ypCoo8nIyl710FiX8GK/hYhhn+Jh23ZE8PvhOyib4EA7nWgXWU2BCYAvxq+Yz6Hxf1ORbUoVTzmO3+s5AKVgoh8tsMsz7/kibyp/5M/fnb35lwc61lW2jL2wecr0RoIvu+QpZ+hsZJSA5QdALViG1GzMx69MFGPirLqPyjrBqNMvJPysv6YuDsWFpeRYO8d30+nY8Bbwank1Bq0CajhY2tAIvyRwjRqj+Kg0K6vcWQH94fqE/mPxInyIFSeR1d9QtbB7KNQMJj8whQG7APLFbqe01OBRAEgVOXb3VJ3zW24SLGA+a8kdZ6hBccN64F4BuIqsV1VutvcdlKaLnavslqWlYT0y5VnGRA8Cw/GnFPmi5zhrj8wVN0us+es/r/nn13pkDd+4ZwFS8lxm8rnM5HOZyecyk5+xnM9lJp9+Fz+XmXwuM/lcZvL3WGZyaerh46R80GKgTVDloFbLPQV7iK7HzVl7vy3Wp7Vw9i+vl1Riu9OgKEbYQuZ3t6aC34XaEdCMW2iU78sCrLzDme1q6Iw83J41pskQZhQ51V1yIuaiYv2b4Aewj/aI5iINzXn7kR93XEWng341HIT7EPBz7oYT6MwFeulGiIu5q/ydzmh76F5dWdXofX8VoFIwZOQS2GRcxiDGD8kUv6nb7BH63RkmI2Nh5+w3p3LGNmker1Sggm36Cpv7koRYekhOFCieCF1/ByXqJk24IPxdXEmXwsdEdUblR2mjRcFUSrUr2FJzBoBUldfckzHw/0O5IJBshRXzkE+GXnuE3dqJ+as6ZxR+z+QdAZ9hAUDUrAH3hoY1eeGNgoaqZPLrRg9WpXYvYfqciEkfIpRerE1+XesB7dewhbUlcThFZGH3ZJ2sTAI9V3xmL1ewbIFT5vuzk41Pspj1rcFgq80gY/vYakferIHWOepupvCbF4z+iipCf2Uln7+yms6/z6LNXKwOuuTM9lX5Gz1fxvuuYr3e5VC1EmUk7u3vHOy0ecuMz9jVCnHl3py9OcXMPS+jxEo2WpzqpaYV0UaB8j0mo0XdeE5cBkxc65NTQROpJpsYwwXQKZszlnHaB49j/HtyOzWz/Oezo7dHtVbleMxTTnP0Uf6r54QMD7qcIMZoB7qClXzR7DhyAOi1dhEMJGQeRmTwuBQP3Yaz1e3CN3YTxkvDBZGpVTrDzqRLgf/WB/u7g47t9wV1pw7VKeg8FJLbQBluM54VVld526ykjiJkABGtRDCfrYrKvRP7O0nrRaoukUfOxcoSFNDFaTtcBxuaApCN+0sMT1v7/asECYUa9FB+PtLUe41NEaTqDtWu1ndQ8x6t2m3etZeey9U/l6t/Llf/XK7+i838uVz9c7n653L1nznnr7RcfUwczX/9jPyWDkulbdCyCVAHoxP1LrbwovADWLwuYJdrsmb/XFJtaWt/52C3NXgUN67+hMLpJQpaIJ5C/PBiBqGsHQH9q7MfwPqD4vwCd3nGFQRhutFtdO7uKGoyijNeadVZqxCBJfgDWIJVlaYXBSm8uGiYiVGfeoix+HZv8CqhOXAHw2+QEa8qfui1iwV0US4kGoeLLnxxcfR2I0E9GIwrIdSwK0bH/qOlmWIaIBSIjbzQsA1GpXHhyhVoa6MG1snbC9KkAiEvALfIwaboDfTqsBnlefVuN+G/SVhOteFpksoHe7hhfbjWJVMJjnuVV6VfIBfgDZfGi+O3sOfsoCBUKyJxIH4nJRwqOliZyQ98MiVHWpeKipSRC0D2J8dHn0egUpiVeVcr4kCv5MXxBmI7d839w8XnTCwCC2PZKjfASdyxW/+Tx67/8d8+XPTIu7/5fXAm0h559+Fvjbq2PXL89m937JXasfwi+yaXKc07c0KfdOP4bj2ve73RKWba7WW51D84m3/OLKWaUOEScFY807hrTV68+0wGcibSL0UIml+Vgq9KDO+iB82JHYEly4dH0mVZYehH0AaqfVxJdQXqwOqAJIIoANVGIJsf+w+CwGWPXIDodt55TI5pzsdSCU4fNX0hzRWo+g+Y713ehctWlZh4OQFBDjQXMCIgPBsiAfDu4qjbg+1Bf/Cyv7VPBjuHW3uHO6/+YzA4HAwePdsRG3diTTzNdDHJ955T3XrVHxzAVLcOdweH23ufMVWs2nt1zRZXNJ/YMzR9CBbF5+zpI99fMDN5iCOMKMaSw9es+1C/v3jcnRZNOC3VzSorgEF/OFlfeCfP7QOp+6qaMgmLgXFXtUsdCoJ72nn/ZyeRBNem2Nve+lxKsdtCCiYecg/cZXc4dc2Fhc8YOFoayx5CyO852/29vZ2X8ep0QR0+kgJf0OoCoCD812BfilZdFzRFWww33erS9iAqMfKQuWimOM2vEIRkRZveAYRj1xX+iS6rE9B9iwNiVYDQSBe9+v4fx5D4sD+KKXWAIj3CTRQGgKZmn0QpQb3NoaKoyKogxFrz6ZRCSRLVvQJ7e999++2r45cnp99+N3h1MHh1srV9fHz0OG4UAsxXzn3P6uUta5lIIeo94kI/sqq+BMaJ1IiGYsgYgB25IN9L8pqKCTmGRCWS85GiaoG12LxtfsLNtByBWX4icyommxO5OcrlaHMit5Kt3U2t0k3MdNq0xIIfyUT+9fXOzsv+6529nc71wSCt/mPvB2ds+TqsCTqYE/ywumasp1SxLJnkckTzIOkK9mCXXYMAX4O14AsaC/zEvkZrQSsZ0Jn8EDD2DnPBxeXfKtG+R17/7YIK8p2iIuU6lZE5oWfVwgSMB0+zX75qK0GNKp81za/NTHAXY6gt/Reb9VdoE+ggwsPn+GfV7V2kxGpFw39U4Rl2EE5O69zFO/eZVYjuZ/54OwyT78MHd0KYfM9kXMg9pUotEP0ds7VpFWgKCTF27FGJyZBlWMetAQVmwmR4JU4D95B0DIvSICAcS6cgNFeIw3ZkZ+deApbKxWWovi6LIudRxuAD6rtzs1hV0vSxZ87dUQJSGMVoGzAYcYKYMFcrKkZ/OZd9lwSVtgLEw2jW9fK5vH2wxFlNckWL8baWue46756MVGZKjrDWc8fAQUy74lquan2OnWR4dvEOFqhbKDpaOtRVbXc3zKW75JgK2pH86FnEPYc4YfKqkM2wvPhWkWLCDRQpFxnJqYE/ul2w/03WcinWDkn/5U6yv7V7sDPokbWcmrVDsruX7A32Xm0dkP9pu8dXKDyvf7A8z+NLNeIaaSBfz6fTImqbHJOJoqLMaQ3U1EzZwvJ4htw9Cno5juvrRVFAXLlKPAD9iYVHyTiXUjm7Ri+YJdrQ3Di8nBTThcbqBSDW94AP4y1fTwWMYOTBRMYFoaWRM7huovukO/RmJLWRop+lrfUqpDY0X9WJXT+H7pCdNuG5YI38NGq4BlAlpoG2F1U6CNjjI18fEcDG7NSgI6nIT2fndeXRBSc4JKY5z1i+wAvX65uATAe/dtP01e5g98EWdsUmVrBaIZN8Dz1+ikf2/368bKwr4pJunEuZ5N9LNmLt/bsc6/TLiwO+cO+vDhsz3rC9IJ2dHb09ip5bOiF3kW4eqQmIFHTz25IJqa+OuGL3wrHvyl/1Mm700V1Srp0TpqI6UddywA5IYHhGV6BjrTTvGBw4ebD0mclZXDjrie+NWsHfkPFvAikAj3vGHIx3XLSlVjVBkNcnR+eWuxxh7ZcKPQDng2vfviNXFhfnrO28btitJooF/Ryq22bAovstL/V4bWCQSW2rR1Hpbqf/UH1ypzpnd/oUq7PCRq/2doQAzs2ca/dcsHTHSOB47zdCzQELN9i7lTfX2laYr//85mSvB6nLGwQBjZgTXBJylGV+UOMAFYnh8q6J0QJqWqmUhnpk9SGiXEK9Hd5VDgScf80KqqiRyrMUWr9LX2hBrxGGtEewvsGU7lztbW1vhAlWUATVrRsXqm5PGh6OMIZKwO68CcYEShSE8ltpjAmAfcdgY3IKglA/6NauQc9d/013XJICUiDweIBlzap0XRwiQLQEH/vCKejkhcnR31OwHlHMV2/KFxuPUJ2/hiT8ryP//utJvf96su6/6oT7wFZlAK/ybLX65BNAv4Cq2wT6dZXH3NnHSqXaUBFVNDg9voB3k28891tara0NjAudQj0nd7Rdo82yTlKhUF+F2cwY1aWCgg1xQSQ7XxcwXU/9nFKVzaliPXLDlSlpTmY0nXIBgc8yvcZ4JEO5ACXQMpX/LEdMCQaQrzKLMLgfAMd7ZyLoFxdt3zXKf9X6787+PNi/2m9nc6RFmZSaTh4iLkCpmezq7iI250xZRRvSVeHOCXWwo7owLoKgqsBin4biMjW9jxu8fVzJDaxsE4l5FynNofAstdKcpVyt7E0kBR7CH5SYuYR+YF/2lpfnpzdMucKqNah897ruhSIkAxjpVk311Diuq3hcD1VAM66vE8VoljSxHj43gMVIU4V0eCwJ8mJCywnbAAzhetnSF3QyUWzSgKMjuCY0z2GoesNBnwXkIle3MJV5ztImHML9SYAIxqulge3TMPG1kOG307lQO5LjiC37W8QrXsu0rgpODE9ZGh8v28j6ul6mj4UWpSJvmfn27N1FTVODnl5zUd52tF0NOuoptAiaoC9YuQSl7N3by3cX7x66TBMmk9+BcwaG+Wdw0NQn+jtz0uDgfzeOmni4X7mzxg71d+mwsQN/dtr8vpw2ds2eHTcrddxYkv+enDfReL9uB44d6J/ZiUMaZpgVrdb6D66vWKKNDu+ZcYp1lfWtydyX1hz6kQ7B7mvPpGKmVEJ7nwLI885Sck8XyJefo/N/oG4RI3kf6UBrNCEYQvM5XWhSwis9KM3lqoUGp9eMUcHFBIrcCleDVNxwJQEEMa4VH6pVY46PwswWp/kPR4wauHOHXZTpsBV2Uqb2YG3eYMviRVeafbBZ03RVm4y8OTqOhxIehpqvEjENPTIlMPL33x2Tl4Pdbbs0upxMmDYsOySnNJ0SmRpmyAuHL94jB/1RFHhq9e0NLAnhNAFnFZpL8nPI7fgXmbJbmrGUz2iOyP6aTPiN963AulfKoquQDx1TjWXHobyHvdANmzCVkAtU6fmNexAdqc734krihBani2LKllz66z+vDQb9waC/dwo/d/rbO2s90vpwNy6pdLef7ssv69s7+QZESzqgCOAYEbeIuMQHwW994WYnh4FB5JeS5gAkGdqMdHSw6FKU6JyLqLL7ldouBVZYFhlTxC5xxrQzM9SX1Uj7fMcBdIXhEjaxO/aLm4iWGYfAFSlLsGrTPA/16ewWU2OaNtA13JSBtX1x01CDBAVNr1lX8a0vQATX9ldNBi5WsxUUSxkE/npifIU0WOVeCPT4CuggdTKmM56vKpnl3QXB/sgLL5MqlkGh24yNOBU9MlaMjXTWI3M0iHZDM+HTnfMp8ycsBPtVQm61/Fx4p9TxYANOpLMqLjeG0tSu0xv5b3rTuWOumRJsVcV2W3PD3sN0QLVWdO5K8XfOaDfZTQb9ra3tvovK6JrV01qLfk/7JsaQdmS+a6P8s4uaPurqt9olvn/HZ1ImjNQ9Uo5KYcpP8Raq5ryTt6wQsW79g0ZOP3T9+sLeUMTLFdHGJ2Rz8lyYKvcvMgiMlKQZqLZMAU498GLeAEP0j0MB5zyXc9uyUxrrsPPkhY/fYhuHJOeivO1ZHQwoLfhtlc0+b9XKOcMhvbuwGuf6umIkY+ifBuObU19d3FPO0f/M6rXb7BOjakFCeEVCznNGNQBak1KDEc7eo7JgVtO1ug4k52NXp8cXPUvVQslCakZ4JAdQV9iyW6uBqT7iyl0tUnDrbDyEdW4Nkq3dZKs1i+6T8AR1+xcF7MeGPvadVOQ4l2UWvJPecYq5ZhASg2YaxPvL+TUjQ7OdzFjGy9kwsRvwZlbt0LZrNMS/9MDAW/lqPepvnONWGVFCi13GlI6y9cUDaxncJXhesFSKTFcCY6hA7iNOm8u7s73XHpJVOL+W2GUAwHzq0GWYMYA4rmjCH+ysaqiRbWMWDMpKs0+cuv9ViiaWQOsabCdemh0TekN5TkdLkPuP8hFThpxyoQ3ruL+Bnhh8/bVs7tUE5kcT/93F6Edj/+3D9VsDWyXqvaMo8EMIEgeHoHKh3/FdNAZjIjJOQaiQYjHjv0bjQVKHPz9g3W0+JkOYFc+GdpfhH94dgobPVIoxrmmz1LfI7N0rZGS1drWJlmzIlfhK2tvQrSoMoXtgT2du/io57cVUKo8DD4XXq3CwOpFqlXgsq+0mn5L5yoCjj5SiCzta2OzQs4+wAC+nm0ctfYs0/BLXfEQFvaLZjIu1HllTrJDKistXttHYH3GfiHNjGilrP1xenrtP7oo4/86n7wSkBfsiVp8GoLCgDpYq96qgZpDPBjWHI0GWkVLlfu6K/VIy/YjkNf/iSGaLz7URI584bBViq2/EGJ6/MXwCo+hawYODl3cP3RXz+pPJT5fO8YTb6JNU/IHluSRzqaIS9C1qrmAPXEqsRn7HTnhhJwH3zZRRq/h1G3G2dneWb4yViUHrR8713pSEGE2n9bWpXee5nGgfNB/aTnPOhMEMLw1411D1BirNULD/N/3QPKuy1VCZxWgtIqToa0NFRlWGw0BiVqEiw3/23+PI+mcnVVFvKxf8s3/sBsqlsN8uKYyxvcN29/Zf9tnBq1F/azvb6dPdvf3+7vb+/tbu1svdR8T5+wWcMTOVK1vE2jph1xGhzxW3oq2E1J+tZD8ZuKKU3nY2KXkG4PZzqkN5lcOqgbXLYNjC7IpZac8qi3NAjAy5khhv9kvJ1IKLSbJWi/+Q42oYaDELvUPwX6FYiq57ltLS3Sm+BA+kSzazOHC+fh+VTlYEuXJG8wXJmHHOJ0Le1RryBeBndp/FiQBcwCC3k0Ey6Nw6359e9sj5uwv784P9IS8ul++FFdciXX/DXRWKYFe1HKl530YHMaTNwMJCyYlaNtiIanQHerMTeIvbF2BlrRLS+OeHx/hC/xKMxHiOE3IsZwVV3vkzi4dMQ6NzHpWniXpbX9ckbta16q1rU5YXbhe41YduFKNGE1kha824BoVhAlW4HfvqZhZ8Ridsc8IfXEHK01uxMVNqZbBe7113VexnzCQ6byQPBzrK5SSGad/smJMupNDsN5e9cBgPFb7iwT9LX+f3oOPd4pen528tf7lZfJ4A5ibztTFtN6wvx7WjJf+CbNu12sG38ZvHMO4alw6tOgHzi3NrR2htqCn1koDzh25p9F8tW/r6GcSOl8ed7w7a6b+r9dfBeO/yu285f1yIrfWBQ3XLw1nj408B5oRmYtAcD1yvWCqVVR4gXgqLXuGvzd5JzS4I1fTQpOLiRQWRI9xcDkNkzBWb0zzvESVLqD+cS2qPV27FVrURi43hqN2GoxZam1KRgQOZhpCqVAoRhNMz9zrKuKFVSjQXkzxqqCIEDtC3ppnQUkHYFtEFFcTOagM5QzwSH1XWSZAONICH22ZozumqbGxhG2GvGLJVrWdlxe915AX5ta3dpdxu45mv+47gMkBoDlVBekSWxv2iSDb7FYySKRic/VAEnS3zWLuXH8qZVmYSqOh5dtIkZu1wVNS8ePvmvOOc2X9nJ0tu3wer1it0r5zF68iW76juuvVmeq+5VXiTkzpffB0+uBMC5KSFzgFmZHvD5nIygZuTpVMquJ45yzl8COYYO5sIHBcMOhUiiGWs1ep+EhWk1Z1r1/Pm1CpekE25aZUR339k+q57EvVC53ISOhqx6FoFiCUytMPFx5JvhrWJ+LcCkpGRLswBMEq0LJVlFbUZWrHHToJlcfvfDL1gNCoNUdTFVJAhjvkbcEJx4YItTo8vHPkegTsC1VyfthZro95ss0K/JThU5gRdEWmV2s0/VnLWCNUMrd5Vwp/cp2x/aOkscoSQOdVifd0geAUCMoTx9UgmYb38rowSG7tlwc0bqjZzOdkclwIKBeukOnJ3ntDaKa0Vz37CgKJgorIzDmm4fonqqNaBbm5Xx+4kb8jTjniuKQVaZWmVKHbDFOTRmriuEcfoHCkc9NhEAjYPHgloBGOT4Ey5fjPJcMXw0C3s25VSsZAlWOeK0sQnMfABy7H8YMiUKS/WXCAb8F9txFAxcsb8CiO7Gs6pEsMeGTKl7H84/KjkGpovsQIzpSKLUMSUJ122midJjIzzAbFjJ2nY+5YWRc5dkm+ow1TqEhhXfBiJK/4SAAtyqn0ODhfccG+pDb2A/OI0L0rSUhs5Wx52LdXEF4jFcu3JSEqjjaJF8q3/rUVINN0mwOBy3pVrupTBuSyvuyhnW4wyGUJ5YedN8Gqq26YQVo4EcRblJvpL45h1UGF3+84prjKlv7l1Hj3zZcylC3/QB74GsNKUFlChKL5EMBsMvEWpwfeqzrpfse0CiwlX4ZLzGrZa8m960waE8ItRirQbE+LJisrVlsJ1bw+Y8080qX+Pvcbj4h71CdKV3EWe3dR8MHbMM6YhrRaianyass8ljJ8ITWMVR6KLnBsEpjOkLOzFAgbSImekoMrUIrAxn1FRdD6CUco16wMMkKhx5iMVdoWhbFgGLcaqtCe5a6UX7+vaNPxke60JJS7VMrQ5pTdW87SyyoJoey/NZIbVg0B5pFgbAgN8mUglSFFSEcHmwL+scjGTN6x+RHJGhSVQY8hNA2DtTEKZPZbBqmQyvXIx8fZ6zLimo5xlREtL+ZTCdT1i4KaLkz5HPq8CLIvuclDMKM5CbYzhFbKVJSf0ghVk6xUZHBxu7x9uDRC9A6J93yxIXfTqLAwYIBzh/n/ACZZQPuauM+pEihkzFPBIYoHJQZBEQiiKKDNumtfEDaeuqRDJrxkj77871mRvd3vXLu/O1v5uBywlvjWmKc+5WSSrsC+uRzN3tfmIH0BLtmzGsYZGj9JUKtQAZDRTu9fsVJeg71Hhr/EKYq+ybImMbO90b6LtnU/SboV3bURBKz730bR+byJ2zA8ORdv/4OZYKC7Vw0p4PW5rNLaF77d9MB65JVjVJNfkgHxTEe0/gmSf1HlaKE9p31d4b7DbgqUu8CywerfbGtiNr7aWhHvstDMJHLnDQD7vOH7y1AUt596nrqYXO2EOypTqKWQ9BUYUq3sVsnaz49AuUq5p3z47udjoxZqdVc1ag3eneyLtYjhjiP9ymNw5dKsowlXlFUU7WG24SE2kj1qF0d48skDNLcr+TWWBBriGctg5lM5tsJSvhE2wahn+t94kocM6UsO9Ngc4OpbsjMhg8BtuimgUnfvhNOj/jdz7ulH2be3DT0Leh5z5GkAzeodms1I48RBNcPKGKSfK0hgTGoREbCcGWNY1e6dHCngMnLNv3UfxumabQGNWpr6Js8Me4AiqrBerOlJHqIlN+A0TWBEuHoWzgxVKGpnK3JlUvBFEjbhRVMV4A1Q70BkXSCMmGmX5GU+V1Ezd8BQQhEuDoGq2swUqLNXD+npRRCYxnv7SszchG0l53SNmbmVM5QYzr6WwckE0N6XTJuZgH8PEZ5FFhiupiBtLVc7O3mZZCJjEsnbBJrCZMW3I2TmRc4TQLqQyuheHR825YqEeYHRHf0YwINQdRlzXtAwuttC2RmcoWTvzLjjLwE6PL9a6Dy/ls9aWWxKG0qkdPyYEZR1jUDD2BDQEiLSClRpJe84g7akR7Xo2JkMkPMbJDEFYGdpF4AKte/5z5VD5emToD7f7CkUiXq2QLmdLbrn9gxZhHOcxi6tVhhdDKpAcB8eLAPhJP2lydu4qQuDuo5rMWZ47Zhma9ce1wnOs89GoQj0xUuZ9OhFSG3ub+iBiI32Ic3W2x3k95/s1o0qQmRU4qekqj203Uc4nU7MZiNnnGVTNWCJ0Hk7f/Yd+u/vDf7z5fu/Nf20eTM/UP89/SXd/+vuvg7+1lihsnRVYd9ZOfGde0vDXgVF0POZp8lG894XGWUYqa8LhR0E+hmY/km982MVHQcg3Lu4Cf+diJEuR4R+yNNFf4C8WNHcv3fq/4pbJN6QUcBg+io/ixykTZEaLwjIFuJG0dw/ZW9NpZTMpuJHKYxGzW9OLm+zwG1WsEfClNQF4WEuVG87mPVfcJVhFNPm45ie8FjctFfm45ma/ltw5Xk9qqUjBFJ8xw1Rr/HHbfip3j7828Oayho5q9OicHC7TWo98XAuLBn+FRVtzs/XLFhEi+Sgqy3HtFWensvcm9BpGRKALqjhztRy4RgtzPFIjAzhsQ4ryGqCZS1hCDXKLC8cJnSRo0LaXdK1ZHGY1k9B5rUd3KDr68kCFcaO+NW+4jAZxWSXyR2n7Uey6/fTs4lwTqeIm/3H+NlzxAVQgWes2HAM9W+xlLNWcqoxlV18K7vDs3OeHo3c38jlEXzkTcqHkbXd86dar7WQr2UrazhVOBV1tQWTAID33F85bNEa88JfBfD5P7JgSqSabKBtaMUVv+iuqj4Ntf5DcTs0sr0XDEHLhricQm3JXf9q/qd1moTmfCHcxgkD+lpnvcjnHRBv4zeXl1dqGHB1UK3wiRdfcOhdkv70QQjxoCe42xr4N+GaCqTjshGaZu+Edsok9QV4MusmpcA/XZlo7pxApKJia2f35j9dHb3Fn/tLnov8LfmAoBqxwTRxeZUKOciuy1inoxuUjGmz3CUf7OvzuQh9gDtHYGtElVmapNQvj0UxkLhwH+AosbPCFHAy2k61fCBMpLXSZO+nfajONaL9aw6iy/8TYdY/8yBXTU6quk43aItwn1MxOKHGzXdGpg3VpB5zVwhY7OceD48ii2a3Q0vPOmS1wosvCyu6c6iMDB1cNS4CKN6IZYQU8yAF2KlEFCO8PdddUv4fsnx/5mLemsxQJ8T4KXpci5+EPH6PKuXc7lLnqmw51zn9Z2QOcYrdcodtuR337a2IFusL665eeZVe6GHI/dpuAptQjOVwn/6bpdS8K8guWlq/TghAlxEcZOX70qyDtheMFAfKzknjQqgR4MTSLJPX/xP7qR9tJ+xXlc7qwkkuZFT1i0qJHeHGz3+fprOgRZtJk4+tcEZN2LMiK0LxcCP67izPyRmYsRyVrHqNu+WPw2lI3sTTdRcpG1r1Cs7RHCj4DQn+dZLYDb9H5j3rn/7Fv+xAa5Npz7zjPxbv6p3eXlYzi+5u1JcE7QwMccM/uuBJ9M1J1GPozBqqqDwLHfK6ebx9jDzEw/JMt9uvqjTOl2DsXEYZjj0iMkheCGn01SWwUss0Bg8hNFTT4AEXXSjYzkqhS3J8ARMuxsd0lviRBs7ql96rpHpmzESjGYPrgwqgSsA9D1vpmoWC+0K6Hbfc6QGUrcg2jUuCajYcU9QiRL7nUoPy0mrZUPTp/E/LrAmGj/Rr5miim0C9xNbk7yeff8DGhIqQbAtVxnjrsC+3TBHBv6ErhuYPeMAvXKkbcKZ4m5I2LUvulZCU2TE4vX0NxVClgC3lzcqEk1BGo7HShmVC/WTE0XkkIzbVSoqeHdoABj/CPsXoi1ueq1f6sOwh5MpWov1bpXeCNivKU0LxhSQModjV10nJN3BBQjSVuBkLUqNZ8vPB5dt5qTMgF5qtRNauZM2tte69UU59tZK95bybksJW6ncNGIiRZ+y+GnnUDeuhl4eD3A5GS52y2L57N1qLxnz69rUWRP1q+W8dk/6jiZjy9P6zU2THh7mJiT2NqcreGryfm3VTBnnrHzO+6MyCDsHJPU8UgSrh+x/WQgZ45z1aPnDp3T/3+PHnzU4/88L5HXrOJfcoq3V1EPy9HOU+vsDlmHkr851K1z6Vqn0vVPpeqfS5V+1yq9rlU7XOp2tZ0nkvVPpeqfTgdmpVq23pD5VF/IiuWt/GsxIwVDEp/FDuWrx34bMh6SlimFpH/9JasNkn+yKYsP9s/qi2rNr8/hTHLz/g3tGZxkcpZHB34eGtWBXhFseXGLec4aMuSBdarWsP3sGSdvPnpwdT+/EjkKtK4QoNdLq2sqAZ7rfx6e1Thvedy7B376AnKsT+NreK4Ak26c419chI8CMvmsvTiNMXwZi0p0WPiRskCoWE+rkKGg4c/eN0pgpGwvEJKRYQUqSZU8F+b6vjZmAgZ40BBwgZjGcvi4pZuXDkbG8JmhVmiRG9dQbz/xfetBXou6vxHwBB/Lur8XNS5It5zUefnos7PRZ2fizo/aVHnQsmsTJ+wWEfLEOx6XCLgdQxdb0dFowLCDFOc5qtNY/RGVNe5M5G21aGVFcqe1stbVOrrlKHHDSJ3Qeu1+k9dRVIoi9rzwrxr0KdLVi0tCqaTLnBYn/iqhhV3GHqBGZBiMw3/KeA/ILjCLzLPGeDJolXX/lYFxy5BlGkZL6syDTXIjqcj9j+go/tv2ovFjArT4XpZyjO++JDDdq3d/RXoZTpl2kA6mWvBR7e3v7lHGUsXehyljCqGhhKfMRuKq9f6rvjujAo6wSjy1JCyqKSVh4Dj8KvVcuWj87PAiEfMHrWA0U6VWhAvgNEmCEXYKjdbI2bo1kPvnzQvtVlau/bJayT77h877FKtrPD6+9d+0Hax/PK47fYl5rLaHXcZK4GftxgQPlHU/RZPvYGgPysQIleozgakbNJrQLHIadqt1F6XI9ZH+efBAltkolmVxBB1Seh4jICLztqMAGQvxlKhVtk3TFBhujgz/tPMlMUSXcfBiD+UJp5H/1ZcxPfvWCdiDMesvzZJw7TpFzLrp1k6f7DAHua6Qu3v0olnD5unwyR56ARXOK9YRvDcB2VRBEywx1QJZpjuESFnNANzVS7LbCxLkalF9y6uXrvP3O842EGUaX3zSZzBuDWflMWEsrwKZGpUa+M6+AH8L5Wzggpvg5XKxarV5PFGHFcMPKhDGa4pywuAZ6RKUYFC0ZjnlsjQDpT/9qZnQHwGtVjAg958HYZRzecxhVRW5oBtF+7HyDXWMK43B/hnNPjFe7QyAFemicYxCJaDi/jm/FR1uHe+/E7AB+7e9k271cPF9j+U7+LZcfGkjos/oNfiz8jBnshl8Qf0Vzw7K56dFZ/rrPideipihKqq9I2Tas5rH35CmKlk6+WyDMjS2tAcq7og4ITvOwJcN1VlG+DoEA/basy/WMW3I4uLrkHNf623C3kZoXE3GGzV4T9UrUGgPBShTGuC8QOMpSqdcsNSU6pVMRi3ZrWuO3fB7cH+1X4bTWdU8jxbsYV3/cidx851BhZnR9W0yYWtVLEKv5PCJ1GNzgAoaTklN+TihyPMzhII1sIAydY3sQSherw7fskOXmXZ/tZo8OrgYLS1zdhgMBi9Oni1v3+w//Ll1iDNHso80ilLr3W5qjv12HXXIqSfPeiFN0yFklDdeJsHo53tVxl9dfBqh+3sDl69Sl9mBzTbS0ev0le7bZdiNIgVzfSknokHgK5NbhPN6F3BRChGoeRE0Rn49nIqJiXYsqXbihoC+zcVyzkd5WyTjcc85UzUhbmAqdPW+ZHkVzqVK5M9zkQGyykmZCrnMTGgcFTYBS5TvNRM9SE1sEcmuRzRvJNm+NWyCbKH2DYyapaKs5eWGQMIaeeY2xTOecqEXplc9xq7c7V4K8t7PFrPZCL+ZMVBagUfZdztBbRH6QhbjM01Ss7IxfnJP4nv7jXXBgssRPKS1nyUswr/VxfZLWD/uib15kY3fzsqaDplofHtpH2GV2jG9ldZo8tq58m2crK6csTn1Eyj0hZ+nXnnpoxL+5ZabcKx2jxmeU7V5kRubiVb28mrzdZ8FIN6NytzH/0gZ3YqaA8NnccOviC1gQzPdSWE8XGN991dSizUKZCWt9rN+dA704p2D6DKo0qN+R1o+wJ81YVhuvsu3N7eacf+rFKx9I6QtgwEgdlOt/KyebxlsYT2omA9XzDcTGn9EXTlVs4ysCwFoLhDoopZj2TF9aRHRorNe0TYDyZs1iOihI//TVU3z1HFg/2Mq5VO/SZo91ppVHB86wpVU5c6JT8wmt0L0rBTm/oRdW1yLpWxx4qc3rK0xF9fnJ9uhAKRvws15fj8Q61bYqiaMBMcElBRt1Nt2d99sHRdcxo99cw8wI7vthbRhNXgXSWEjFADT/GcQZH3bkMclGySY0OOpSqkanrX70WC1UvegQxZWwR/BBXOaRNR5B6ztv2sWJUN027oqo+Y8n6yk7zaHwySrZe7W3sPnTufFVOqVyUMRTWTQKGcQWkkLHp0furqax8JPyrS71sBAx8jtXH2+z5Xx4cRjbmYMFUoLgwZcQEFVyC0g9CxYVZMQVKizUAqIuEOS2XG+jDn0DaipXvzgsYyyDJNS6Ws1oNCOwInp1PwCkNZJaNoME/A6NFq+skaTPP5PBlzxdiCQSGmUS4nm2aqGDV9xbAe/eb2YGt3c7C1aRRNr7mY9Gc0t/JVH4nTtx1yMUmmZpZ3X6CDdP9gsJPuslfb21v2lyyle6/2dyjNdvazbPzQnePrSF/B8Vl1GrQl8udyzIvzo7O3l8npP08fOvfVBmuHCXdFbD9i4mvhrvh4e3TqJQb4vemgXrsPZSKqpBVWhhdoah9+KujjXtZh31F3yIZlCyFsA+r0Q0kph3hbaw8rQPrmCM82o23sqlnV6qODN37ouy94NiRybJgg2tCF9v4K7Ipwo1k+JjQUK4Ig8IIju7IPok3EF7oCdywON/Y5PEQ+m6wKAWP9SCm6cAV9gHhUTQC5XfcsMZQJvhyIKxxp+X/Ye/umRnKkcfD//RQKJuIa9mcK22Be+mLuCRrDNLc0zbTpmd3Z3jBylWxrKUs1kgrjubiI+xr39e6TXCj1Uiq7TGNDu93dzLMzD7arpMxUKpWZypc0V8T0BA2upyD8ziuogah8hydauJtYG0OxTHCtBUIREqroXancydxcW7Cje5TtSDncqKGN7VT/N5dE6P/fqEf6/xr7Vcm2mp5dKKa0vAE55f0hbKD88ef4SM8DAUaT6hb4xUHncmVd4XzbY01TQX/q5fEtUQgznE4klYgzNORjP+RIq5l+ndCYCFIIDsXNugXbC72Dk8q/MDJrEkRaUOsqNAqMzGVGY8pz6buuVi/LEqp5QrqSDhiGewpyT+Wj26T0OE8JZvPW5I352VysZmBj0D7CyM8WdlKq5DElcvLqiRjpvygbrLB7pZZkgcvQaVEakBJzzmZ6u0ddy0mzw4OS5SPM8j4Ge83kxRfeIJMmEjZFd5e61Pg972xvuuMsSwn6+/sOlBWqZqOYjyI9L4nusziCxJanLoPCKl+dDP3cdVZMhDJ3KwQZ0KqXw4mFlJveX7GYZIoPBM6GNEZECC5kIYzDUe9wSpOwqKC2q0UulZtP66x3BOWsqIJP+66GArxavOJi4Ivx/bBjLFHO4P6KJHOaVX/48P5D9+Pl9YePnevTdvfD+/fXT13OHGpxraoGXMdMV1LPIMAKakmIL2a1T2GtCB6tWJDoKZ9TmsB4cLcIyV5w/VrEARgtOCqER3EeLS5ETn99+88/Dt8dHv/2VLLrPbDQPdZnTqZXHcUFkbbzUbEnK/aZtkPpVPVJmhhFvnh93nvuYNeGAwhxbeViloDj3g9Zir2BWm+l3hi9CXQndi1D9flP0om5sjY3AkagVOtpz3GGgkB6RvJXawYQQk4HVOG0rCOYe3Btgg0wZVKVdFxwSExMp1umyi6BWfGKS2v0CNm5LP1GI8ySbkq/ZDGolUQ9llfuLE9Thx3S2BmmBP1WS5tCIE2HxjpDxQ9tDZYpQ8VsD5ymhXYcrCkk6c6ozU80ZUI7Bm1Dt3CBvPmy6NKbfIgVnhLzcwqqjgYyVRDRiC5zYECrIthovSChw0RjmKZyMkySLjSbPhpDcaJSDCNczEINZQeICQ6Grfjx43m7hjoTOeLMOTLQLx/P27IWKkw4aAQ/0ptZo5pO/JFm6rH7xjxwms1ifcKZVCKPQWhj6xtIJ3a4kHJQbUmzN2coE1rUxhByMqKKDkKt6+q8jQTJJSn1ng/OWuyoGXs7nkO2OR1pHodm1tNpLMiVC9XU41LNEelxM95rtZKj/tHR7kFr4VCnYq9945LpsxhPpzFPuUrCPVJylVTQPJQRFRSl81oTLOfMuAaRSJXRccs+jaKQODCrImLk2k5AtmVlx8wivBj3tLltj1tfH6GYzMkOm7kJsTBl7yMy0n5OAEtj92BRhtRbPBolrRUJzHftlpmyGhA5LOWpf0lIOm+PG58BpdnaXx0wzdb+Z8BpNZqrA6fVaD4IjkwIqSgD+WXA6bRPT6+mwFkgA/0HE7Wv3JFuEqBK+ps+ASWKbbirifG0hr1Ako5oOi9QpUr2ZlhooffioJ8m1nIO+sfe0hVUf3Hjr4Mb3y7I9+fNr0bsxan/dZz6c1bjxbf/Tfv256zqj+Hir0b+xdO/Gk//HOq/OPxX7PCvXocXv/+yZHxx//+g7n/LAS+3AC+3AGt9C+D49Hu5DPiynv5FifpyH/CE+wBLxa9+LbAEuF/38mA5gL/yFcNyQH/li4hlgP5Wriss0N/BrcWKryQWo29Gou8867NA9CX/MyDGj5kJWuD/I+eEFlR4yQ59yQ5dLju04KEfOk/UU+ElY/RzNBos5IZaqk7LeeE9sbSAtMogSsJ6350V3iN6HokUX1ZBzaqwqryWWq7yjE9dra5429xrLgt4JeTPXTdHT+Wo/Qpl89FYuCuLRQOs/gXweLC22JCYYo8hi1ivcnUwQbPe2N+ut7abu9f1w9f11uvdveiwtfvHsi57kPvJYq24l1qZa5gInbefm60sBis8Biwqc4tcG4i2F24e7hCi6vtyS3oTFDCbcsxprofva8bLbWxS35EXS78vTOmnE8xMLdUeQQntQ6EwVeAR9P1FGPUEH0vo6qbgQKHKAuGco2PSM1XHQNliKjVFfaduxxZZvTzTGCy/m8r+DBJzlpRPkCHWpwhhKM+qi+zuNpfVz8dcaF2vm1BBYsXFF7RcV8+FmtksgsgjOB1xV0XQnSEfkR2cLtG25Qdwdrx4OQwVflD3xg/u13hxaLw4NJZ2aPzgnowXF8YDxPlWfBce4PXxSniQ1tXf4AsJr7knwWvia+gjmIJtna1/D+p3YNejZRPivk3T31FuvYx6B9X3Zq4vylzPZtEXRe8HVCpPR1tv8kP520c0TDcFIkGpdsWz3SCuuRZncvFy2VBkG0rBr8rceG8VVFOAHo0FVYrYMpc9LMn+HiIs5gl0fPKjnHHhEReziBeNjjpE/aZ1/NN7iH/+QAa/5kRM7He1cvYAlLKUmdk7vAjRzThlNn3gJs26+rubyOeI8MxaFL1cOf2uGLNHlDNt7ojAPZpSNQFYilDBIqBeS5gPp79035xfHn/4l8GcJM5MqTQa/vj1TX58Uj/+7dc318fHx8fw2fzz86JKISy/OUEfm9z4pMU/MTkGpvmJXnpo+wXzw/ChZXbliYQl0nIakoWr3oQ1s+vnmCMClpGUDYIT0j7vGQimRJt6ATp/1GAhTv95dXzZ7nb+2DK8EgZ4ehho0JmDM+IalJkpyZ85YTGB6Gk7ITC3Hv3dx4vrc5gLxnbDpWnYJO4OC2jEg1JI4TXDsnxEBI0B14Lb9Zjt399/aBtmP/2l+6v+VAI94MyA8Xw2XkJiOsIpEsSmnBpjfJNEA3Sz0di4mROO+urfGyevPwmFPwmSdJXKPvUo+zSa4CyLyD1ZIhUamLG6zcTz58gpzBIskjIvmAPfSh+XECOrsDcssyiGQ3q3CuSOez1B7kzbaLBInZtWz195fL39x8W7RZG5JRXawbPj8pbekW1Tb/jORqHzvh6x+hzuvD+7/v34w+mnwpJ2x8bl9acTo5f9Znx8n85HWlk7o74BhWb89zCx/DSmTAOteXlhU7uyc8+zkwZSiPRcYYaQXuKaHhYkApwj8xb805OJVdK0Kgj3qU16+WBQyjl9XFeUKbi/FAkvA38NzOn0kUrmWgSbQgEEeTut/4VffqbeuK/NIInS6seI2HzSPo61coEVQRm94yYbR/CcJQijjJJYo+YGhWYq9gMkf8EDcEiFud/WgSu14QA5mGyCshTrJ03/+NOTjs2GQNchCHZo4xrVkFiZM6qZ/vPF6cn7kJUGU9ierebspiJQyAqb3mbpM3RjaRndeEyOtZCOBVE+i0pT6PwK4SQR4G5yvmHnmYaWZkMuVQ3xniTijoiaS8kqFkDZxI8ailNKmKoh96jeVYwobSREfS7GWCQk6dIsQud904Q/y4hNvDu/8nXmeQE9zW5qpu67aUzELNGAYhgN6B1hGgUl6B3FaTqpIcbRCINaGbZHowomw+AB702KSh3BVK8bR82oHjWjRutmiWrtK7yHOE5Tc0ZhOSTSsAdnmlDCMZzVCE0ipNsW0HS3LIlyacxzyPkuaGtH9rX3KUOSqtzeKJj2ZxOevxKaTWQuCGThlUZ2ACKcDrigajjS/LZp0pKJIH0Ob2mG0yIYDl8PxNbC8VmQtLZC+uv59MyyuIvRXwUZfdULY5tnlUgVvmOOJ4LOfm1fyhpK+AhTBjjq/cnFrbRap/1Kb4SUYlluUPa4XjGLJIaUHp6hhj0Xzq8qkZ716MjSybCKvQIJtmbBHoLwMcdU7pfPnVHFNw8eUPo519YCi+Luz6Wlg/PNpm6ajFI28aIXcUcyPNB2oQbDJuu73FaEUyJUwJGMm0ZhgGRhMLoGbnqKIM/WjmauBp35YyzFAHDLoK+daHdAJSMq4TpY2wKCp75ju6y5RzVgsEnO252d86tO8UOfCjLGaap5nPTckEG5muCBXKQ2+1rWEGEJeCBQQhSJTU0Wpk0VfTJKgjZP2x+2bLdsn99LVLxMO45cDfmq2PYSmoJyMcCM/mXPVy5QJkmecDbxPXwNUCAF4C8tnDmKBcHlUhaoWEfHdZ5r4ICY2Qeh9dpRWGxfcJEsYara9varilA5LvrpG84xuqod0iBvU8xNurw9Ah15SjTTR2HBWC5dfT6pjpUio0zbkueBonhB8O3CBv7KA1quwcExE8sCLGNZxdFpPgHepDy+RYL8mROpQFnN8l5KY9S+7Jgk9LfX11cdtIOuLzpQN4HHPF246/7KKhwcG/zP20Y0UukS98dUDW17IOjlbKS1Ec2BulziJi+WKxlvYWZr1BcOol5tc+TQYkzn9EmeL5FKpDOJ4Nqiwwn5TI9Z28047GL8SPKs9B6TlCIggA7ghXWlaB6/5y7en/yj277sdPUG615fdBbFe9Xthl99KLUYVlzboA+XVwv5xA9beYL5XzWJ9fDaiDE6gvV7m9pUr15JlPA4L8qRlGcDK1Xv+levCl5kXBXcV9N2Uxxci2KUUnYL+JgwKQNgam46DQl6ziT7W0hPY+kToaodyC7WirBoTG9pRhKKobO3/rTzpKXXWiRZVRDQ5RTHS6JqKOMpjSc1o4MZLcfEdTjNQVufIDEW1mdMdYoRGfWIqPaBWt9298oeRd0zo1kuSsc8X9MzCVxhXPjoohIN4biSxXll7MngkKJEPuqYmhXW1UdWo143/y5K39WGuF4Pfd9ntIMEuaNyWiXqEU0V4D1wRtnirtVoR4/C10cmwVqUTcxO+N2DRuaxfVYzRkL6lJlbQXPhB/5C/RtD2BtfMWfMLmffGzjGZESCDLAAb7kkYN7JWvC84ZceNff/Rn73Uz6GK12RFBbnGRfo+uTKjlozPOrBNLDFhN4V0XCUUUVxijr/uoR25ERtyi37ox1UD1jAYu7+DO96xXJ6JiuQ08kMPRy59bls6QKBtNgODo5ga0ciHKvclHGSxJY9FCO04cfb0PIITtVgWAcFmwJcmnqI9mdrZdvDQp8aCtNUFoeTHdGAAnX62MAtcDFFiIf1PHVKExj/A2BhRwxK14ER/9+cGaaA+0/j3LVvVw1WkJZxNTNkH8S6XkYTrTztkjgxw+84FMpXrMYTiZMESTLCTNHY3Djew5mOGSL3JpS5VjooqATvZT9P9WN3VKNL/yJF8IJGlAjoS1q4N517Wvg5+jj1NgrQFheHk/FP25tvqWiaImI8oqZIHXhWwCcR+MqBYH2apl5u4SwTPBMUKxLUu1zAOWGc+KtS4GA3mCPWLpi/RQDcvOAZ9egg57lMJ4bL4R0/LFznS18LJaVS6dU8v6oh7Fyf4OnPGb1Hkmv+iRD6V0FxnI7xRJp7k0Kj0nsbjx1Mbj/cRPaLG0PGsq7ItDZXRDAkuSulCTcSEc1uNCg3kQHrpoYSkhG4fEHc6ieIB5UXqT6aK6LZsIxYrhWSBdbqoYA2W4PPjIlwmnIPtXUQccZHPJdWZJh1KL4uHd1WqtjBNo87l1szle8gMQHHw8KrZ8hrIrzJnJO/1dg/qqJF6OL6Hsr/oAXD6N4H+M8Pa/2F80FK0MXFyQwN50SkLRL9PT1EuQQ7xJ5BvTZooRycM5bFzNFQveyHe7OuLdhAj4T4SYErBkoz3+wlxIDwKKZqsqpa0ydUTeav8DvOlCA4rQaTM0UZYfMK/D07rNdjvp2a6DwoyOePYxfsA9C8kvPxuTxeVMUvI7qiRbksFf62k1cjxIUaomMILcNzgM+ZEpMulXxV63RipkTnnfewUJWQnxw/CO6q2N+COpdjTjDDSTVl4UyrNP8qwRwQ3gWPzzxYLjgbUJUnRjFLsYIP1bcf/xfaSDnbeI22D3aj/cbe4W69hjZSrDZeo71W1Kq3jhqH6P+ePeg14Cv0KL76KInYdorXlCcfI0fCGsLGx2XUcN5HA4FZnmIRthZQQzJBMVTQ1XZHqaCtVZBU2YNJhVGpY8LMzRzkdaXcxGP2iChKhjrbpjhyDHgpyoYTSfUfxkNeQ7GTmWG06yVXml76QWOCgcWiNZoRaD4Dwh221e60HpeKs+0krlyzjEuF01Xt4FdXMJ0Rs1hKHtNy0KlHpVQcPteKdmEz2BAiH/80whPvlbxlfMwgMBhp1Ew1V4H+OL9CJRz1VgCF/A6LCRrTROt8cORbSQFX1ebParoe7dX3Fna6a5ILMqCcrVJwfoAZPyc3t3+d1bcCeFckOS2scwXnrznpkWpe1nbVX7yqHvzzqwwuB07P5699vNRxYdjnx5fHwXNzkbIH7c6xGIDagXfe5IRx2T2mgix+YVgR6lJJgflhLkWknEPOasGb51d3e3pHnV/d7W/N6pkjHK9Cjrw7PqkGcOpKhHF73WG0f7PDP5ydoIP6XhNKseeDAZGKJK/RqTbzeKyIQpvW2VxDh9s9Wpys2nbYMu07rIpoL9jHHP07zzIiYizJf9CQ3GMXPw99FyQa0DvnWS4HsVrwzcQmoyFn0JFFS3pFBkREqJPHMZGS3tkHjeNBkgwL18+iUCaGk2xI5pwG9fp2vb7dOoX/7m43d2dWkGEVPVOw1KtrgZm0bjbI9g3dQj2sD7PL42vvbbXFr6m1rYsDmqNM0Dst+tvv/tgKlrh8MMIxknKcoB5OMYvhaA6CabhAguf6xK5wVWi8M75QluxSmachUaBWwnqTxfgxl7S5pxKYYaSlreuKtOvK5XrGVHC7PKF4mYbB5IkQQZLuPFv/yzU0GtLBkEgVAOHoaWCpAYJZRhKPRt5zLgI/8lmRzVoLcqVgOOuX1BrXRp/zyD4XxXy0oQXhRvjFdN8kE41kI6wTYgrnQ/1nElOpNS5gTWx8oSm9tTnlJopG5v0+vfcjwjObQ6Wy1zs75hHzRMTFYCtC1ybGWXGjKt7Tkb8e7U2QpKMsnSCFb8P1hlMbSwUCPMU9kkqjFTKuIP7UtJzQ2F9ftKU/1zdiHuW3G9UiNqDIDLd48q+SS/yksGG8kdTPtYT4M8ep6UUSRN+6uMfAaCniXk1cKbmPSWaMK4hyhNdMwEuZhey2iBA6Z1orx0LR4CIGzUAAAsk2LdL/2t9tbKS35MDkylNbVyTGrLiJQWV+qwUUiDlTmDI5i1CPpHxczf7Ve6W8n0LabozH44hgqaLRxI5gGMbsGCzVRiFIzm17JjPKEBfdSgyuJl/QTVPolhsy7zUjmfcapU1ZKzF3AV6pK4WlQjDGRs3sRcaREpimeitlRFA+p3mTRmJRvVTxrAsofQVJSfp9Al2+NBSWiSxlNsn1RXurZkxIbz8Wa+LHNeKm5i5xQXBodnZ8FGygaFaoTs/rhw2KCegVBB75tqUpSNJ5grRYiceLVPhthp9ySUS0WlYKPahFHQAfch9E4CDen3fEYoYu2sdXWswdG0q0/VAhD1UUh9AYkxGm6YoQ/qixggmduVWd3xBpSfyFq0qt5Y2WJtArWRxE4Pj7TMhm2iNCoVPKpCIB65boCdfga8PcJp5qJdxtEF9ZfNn8pm42hsyGmMFV8Y7L8pizCQzsK3SDhytmJq8GbJVlAy1FQR5CShw4UoVJPSwFvJq8RiM4GcKMs8mI/hXAY0jtP340DYVpH90AVjQxUQrwQWN74xWbmLO+WdPpIFuWVOiK2kyex5CPrjb2vGxoVxVAqAbsyzlk11LSdobaGme2ZVDKB5RVEykQtRhEbTX5BE9XVtzm2CU4A7PDzO5mCrzDFo8H05JuaQ8z3MXJiLKNGtoQBCwONujqQR+ZqxRGXRZFhoOwy+LLz2SfEzfGTBCfCn8z6Vrg7SnyIBKsnLdyjCWKeZqSGKrH2W+vh0T6gSEnd8Jz1KcsMRvYi5OUD6SVI74NpJsb6hyYuNYlYsxINiQjInC6wg6jp27OGUFApUdrk/ahtgy5p1LJrZle1QlsOPD+mTBB6bpgCgJV+qRpMXpjBwQxmnAita5erX4f4r1+q17vzxBpJbKxovGqj01mzETrGkycLe1IRSWU1hRUBivF+6Z6AeMJsTe3JVIUUXG+PBwwGBgzCZltDu6t6pmuqSEwtkzUCN8SiahCGZeS9kyNOM/PhXmm+Voz8IgoQWPD41BRaIrLyzUA9AYDB0ucp1gAvH5IMqLKdRCezh655MqGcFJTrIAR2xmdkOIFafZxCQzw/fAS2QsPRBAsanJYjTqFFbrR79kzWx/h8FFTH5RiPMfpkOwekBbp9Ukdk/147+igmfTIUb/eONjDjf3dg17vsLl30N+f4dMV3V2WNGjHhCb2NpByQMXpe6qKF6FPqN3JcG5ABQjLRzhN+diwRUKlErSXhzmwdgyb5CxySAH3fiUoH1DWy4zfy0VES4WhUhXcRRQ7h3l3WQD+ufk2xhIwOE2xVDS25RVKu8upaKEHyjj9c6mCENHCgfKGYCWrBjFuCHvMQSvhzJfj84/qBb0plElTLqSvN4zxcQaNmOc4uUJctu1WnGUunpCVxr44LsOeVQCEKbkUcIgacyO7PIvpEdzLToo6U0f/Bts6SD4Ly2BCyAYE1pq6FrVgcRwpvBgtQld6rq20H9QeSx4yV+PEjfY4HpsS4QEIs5w2BYB+1vBCkE1UZmDLm5EGQU/v6oGUdjgnkr16VeiZUITcBtmBlxyQ87PVprzmXDggbdWGsFx64V3jsNMpG+RUDv2qFZsVtro+X1CelVQGey5yqUFFoeljixRaujAi3V2jFxXF8FPSqcw1heBx3LOFto208DS2SI0wM+kkksxRN9yc23X7T2NWoktVrlD8xQL5TLErM98UDWbtshUVqQP122VSLnzWwItTBfmNs6NCty7pIP70LxkKBqNgklO3kOd9MwgXfgwsyBR007t5jvgeO63spiSVbx4huWeWaW5+3fOv1G/lthFuoXxSTsn+mV2tQo4rjlLOb7XZiG0dE6IQZ+lk2v4JOlX4E6KaSrtRM9or24WQwTNlFobfPWgVmied3eYKvcwkdSFJ4J7QSEWXt2WTmnZMwEFUZQlq5gmyrDTz4HLiV83GK4SpnZC4YwVscV3hoCoBESbBFUXRQqSCTLLP5JCFMR02kazAaV6qVDBLzJmkCdxCapppVSyljIQVY00+kB2155KswAPNynjLeRM6MpSJ6RggzAk8D3wU5q7Mj+0sM3tF6yLMTZYbwBsmdIL7w1Tnqn7OUcFjaUI7Pbm/rYwxS/eXjLGXjLGXjDG7Pt97xpjZ865yeCFu1zBtzID6kja2OKwvaWOzCL2kjb2kjb2kjb2kjb2kjb2kjb2kjc1A+QOmjRn9co3TxgDAl7SxtU4bs1z0mdQofUKAL6k4ornPmqpMjwrqUiElMHhO2eCbTCGbS6LoiTT6BlLIFjO3v3IemZU30zCsXR5Z6Bh4ySN7ySN7ySN7ySN7ySN7ySN7ySN7ySNbGOGXPLKXPLKXPLKXPLKXPLIS5V7yyF7yyL7pPDI1FMS3QrTxgtfhdw/FC27YHvZ6Y6dYStqfuEQTDJ0yob8bjmNuivJDqxEzI1L4njM+mnyyA3/yCqAmwLvz6w+n6Pj6+n87+cen++NT1Bd4RKA35yc2E1Ko5YfGvQRJMbCFw0TIeWuPCt9IzvjXztudGrr85ez3GrRc23Ix5RjFfDTS8t+CHBVDw30AIBQpHCsaR38HiHwr17BZ3pAOhlbz981CuDNv9RjFuAaiTxt0lOFYfdrYikpTkXgIciL6e0iGmUkhaKsY9JYycP+AIo/jITTj8B3G4M5SmTBWM08NFiyO+ShLqTQXdQOOUwNdMe6njaCvHdOCVhuqJm5Vg77xt3D7PCbaz6/2Vzg+LX96EHyIdT8XEI/qO7aZi3XHbyWJBgMBQ8AzfsF84hvsWU/pCJ356ex4dCYYADmTzwb9JyaDBnqbsIHvGoiItg9Ne32FKBsQqUC4GCcvUYLLzBhe6RTQeDAwqLp2BxUCKNyZs4a92QMrU9o29KagxqdpqF3iZ0fcf9mevLkkCM/Ilk9+9E92lFrJFEeb5D7yTY2wUji+jUZUCQJNjcwrcuf6uF6vN3fQ1kYV2cyv8wi2Qk1xo8TrLgXhscQLaTUjo59OvGrazXYlnyLfqjuCAdv5SaHF5xoRMRy+mqCPHWmW3v7g+Spb3EvRp+5yN9BiZHZvyZ3rRr11NIdb4bcHKPcD+kY2ShmyC69iuHThTvmSq3jCRyNsqxV0DCZsYELAM0HCZNfZFV4TcfRoOof0nd04X5bOj3//AYLLvPe1JBP4+4x4CqF46ikQjvU0stfrjYcEVVRfvC/rHMJ/B4JtvtRacHEfFFyrWNwrPiaiMyRp+kyrux5C7dHLEJK+WiFYyTIsNsZCjhvf4815bXw71YdcNtZj4ypFgEfm+qJT6kxY8q/0eZxL5/0OW7a6roWIKknSPpyUlCnCFHRWTCcI33EKLfi3E5Kpoe86WRjBBoj7qFUvNKaYCJuKCPmQxJuhj3cfxDQbLpR/9qQEHIgcQpQlYLLbWD8DgmHRJBf+a5uNHpC7UjRfdLqnJ+23p90PnePu7+fXb7vHp51uo3nYPXlz0u28PW629hfd2LY0d0DfFVHo6vTdNmExT0iCpMIs2cYpZ6S00hxqU/hm9xZWuO8uiW5wWZns3VFu2lpuk/s4zU2MZB/dzKLZjYeYshskKYvt1XU5FBNBXIvktiST75qYUlnthX13fh5F0dMXwEC2at9zuB4BMDMFKEorVHZEDSFzdf6aLbVON6U53GphZQMeynWd+lRIVWIhV4xmWEqEK/vOzcJt1JD763He8opVHGI5jEZJa0WLd1KSimygzRDKVNDu+F27hRIK3j7eR+3TD36NZ6slQPTxI7bfmalsIqlUhMU2rsRk5cONHiyMrE25Pn2ISrFi5t5Nmea+WklyMdMwTuVq1c8O9k8Ozponrdabs/ZB+/D08M3h2d6bszdn9ZOj05OnrJsc4sbaLFzn7XHju1q5o9Pdo9320W5j9/Dw8LDdPDxs7u+fNNtHjVazsddutBsnJ6dvmgvnTk6tYPkI/Opr2GztV69iicZBbY+nr2J5ZLOiz7f/9g8Pzvb394/rrb3Ts8bBcf3wtHnWbOw3T4/f7J28Oam3m/ut00b74PCg9eb0YO/N2e7JQaN5cnzUbB+fLZwiZXGmUuYr093aRT0mkoS23n9J7KP5DETuEyircw/GcnbIw/1QTi5/toV20AfOFTo5rqH3H38+Z32BpRJ5DHd41wSPaqh98rOPq2mf/BxGFC9G3v/i3VWpGzboAzJqisoKBg5bmU7bFUMTbDtBGRGaTTV7djoXO6GtgaAmF0vkEN9Wx1Ile6TVaxwm+71WKz5oNA+ah0e7zWYjPtrv4ebiCXuWXIyrLu6rhRgyKTNFmeGwIjvXFAKrvb0wHhLmiqiUFBqJGIeEBSKCajLTu54m1dpOs95sbNf1/67r9dfwv6her/+xrMajadGDgnFfiRhW5VuYEI2jg/pzEsIUufnCQY7lvQTVejAUB9Lb4/LcynlF0rTUTN/UaRlyqUCaKV5qMW/hKWr2UIn0sTAy7m0I0ALDFCkeod9NgR9/nOiHXUBjragmUxp7QPQKZdSWlQlzeWxhmco1gjh5U/Irivmy62Lk9jqdHTOnRXFClMj2qNNiNDG/wzHR5nE+IsymOT7zKSHzzMQndI0fY+XhUXbaar2o5FAx3wxJmvJ5Rt8cb0qztd/95eRdt/P2ePdwT9uExYOnJ+2HHvUTbTzJhrxv1Y8inEJan6J3BETKqmh9QY2m6jg2gMMmwmx2ji+3IhMco+eTJmm+N5mr/uBcDbmgamIiYwK2h0iDXq5sLJVJ3YQIzSLlWGuv7csOmqYCQps2bT+JsUjkVg2GL0Wjk+polVd/D8TKk5bJaIGRAX+Vst+tkw1CA0GzeXK5pb/TQEGxmoDSfg0qCeI0Tm2woLd0METHUuYCs5i4DvQnyxpmZTpBCYWV08jUcNg82YKKB7KKBB87z4BfEkr/VbJDxbGz2V6WG05+/tipoffeDjlnMRwucBwXmUi10Fap4JzSXn1OLoKCKWHJltWwkZvWycGLrSrivdPMpiXYb5SMnwHZsFLcihEOp5Zo8/0Thcs5i5+ZHjjt5oyuSrWrIgtOkYZAU+fjkuSZ2lXPQCIoatzlogshoau7nPW6gymqLJCb32sO1zXUgYDTq8q9c4JT2ueCUfwUKjy3dQ62KFZBo5NHmOMPWJ7NerO+XT/Ybuyj+u7rRuv17tH/AvPzqUg/qxn+Wayn7e4HMW4cbdcPAePG673662br6RibrNTuLZl0cTrQ+2s4WpnhbecrCm0TRoRruGBTam9J9Yb/0HnSIRjgHefiblWb+9rcU9+FARYEkTTVD8T2pwJz5Nek+rrW/+wLXFfSilGpslZz4WCkOQQj9xlnhD2hkkuJJqd2OM8GCRH0boYJ/N3nI5Heb7V2D8JFYgm5rwpLWp4Qkv61COM8RAQoIUH/8tHjAQ/IDMdwA9ujc/IGmvW9w6egJImgOO0uXFr4GZLxzNSuaDAcvYVHovL0n764Kd+zuRKBhVctzYaY5VAMrFYu01xc3IypGnIwoFOtpGkL2N/ilIaPh1jgGEr5VC1Eq3X25s3RyUH79M1Z/eiwftRuNE9Ojp8kqSQdMKxyTdUVC+jzcr5uuBweqFBC/U6QINqUJpp+slzzB1n1pc9zCGZCv3B0gdkAnYhJpjhKaU9gMYlQhxAf2jWgapj3tEK3M+ApZoOdAd/ppby3M+CNqLG3I0W8E8MAO5pY8J9owH+62N092L7Ybe1WLpO5Udx+4hFinTvr4baQ3m/hwKpCXA6xIEk0SHkPp15fZmThKiPVdFgHt8TzeyUcfuvolpgWk87haMqfPuCX6Fz/XNgJNXTxcwczdCYwi6mMeeC3qGmLMwIvxRflnrV2R5SI8xzYrps/4iGhUWKE50Z+DZ0PFbRYGtUf3IlgI3JWq1kGXYU0EFbNq2Tt3YWRW6GtOCf4ubAefREVaAxnrvVq5pIfZ9CBZF51HEnirNnaFwtbhEQq3EvhIFqACj3OU4LZPETfmJ9RP8UldG15uuuLDmJkwBU197FjDAWtYiJlP0+1sl5SM6EvD9VP2hh8hggDHVF/zhkj6cJbm5F71XVB+V91+X1mQI/AV4APSSJ0ZWsCmlA1RMuBjdBj5fjy2Jbd0/qS07HH43FEMcOQOoGl1uxHhCm5o1K5DZjp3aTx2TZjz/0huh+qUfoTTjO27eDcponcqgiFNDVBA2Ms5WPI/5fVHKuh3WlECzOsIDIfrYxZqZxK+ABmtTBAkYwSJTRr3hvlbprLF2ZRE6PybWQgWFiXyUCYRXNdMhDmQbaiZfjSGQjhmi21Tt9GBoKF+7vOQHBL+T1lIITr9v1mIKzDyn2pDISpFfyOMxAeuYrlkb/xDASL80ozEDoL5RrM5BeUzysDf6WpvNJcAwvIf/HuygJHq5MNDCDPnmywe7S3t9fAvf3WQWuPNJv1g16DNHp7rYPe7v5eI1mSXl8inEEqPMpm4uttsPg6JhsEtHj2KIdFiPHVkw0sIVYb1N55dPj61EExR+jMBBJ+caHzElO93jHV4TK9xFQvSKd1uLx8ppjqCvzW8fLyG4+prqDyWl9iPimmugLZdbvDXGlM9WfosYbXmiuPqa4g0Q94HRpS4YeJqZ5G+vuPqQ4x/pFiqufg/RJTvSDBfryY6jmE+JZjqkOUXmKq1yymurQ4LzHV6xNTXVqYHzimupoO6+CWeH6vxEtM9XPHVFdRd63dEU+Kqa7Cdt38EV8spvpzyK+h82HZmOoqVH9wJ8J3F1M9GwbzRdtRGbW11FPYhW1kWEgbpwnfc0EHVDOziVadcykZNRe+qHFruOKQ4ku9ain9iyQm3BZCRHxEMRyCIQkeg35RKv1B5D0jZ5gVXSSqca3Ccy6OlW0xZzs86nldzzn4HHPTnUkLKSVwTHwLzGPzsCD2OhYianhGhI0+d43rIM4cQ5Rv0S8cI0H+zKErGEeYQTCPHdc2bQP5gFGCFe5p8v+ZEzHxbTGLXdTvH+HDo8NG7yCOkxb+2yMJbHD5ChSeJiJ8NhXlg2brtoOh6ZtdENAGp/aINueR4gOiCVfu721Htr1MHZmHmCWpMW/9JJQpIrZtEDZJHOXlLJX3ev2jZn+3dXDQ291L8D7ejclR8yipkzrZO9jdnyatg/crEdhN/2hODt+xDdWHdDDUhPMt/qEx3ohgmQtrtQN7e3a1rO3JHzK4O5hmCFuv9+v7BxjXe/io3uwdlAiZCyfwbHuFjx8u7BcPtVf4+OHCNU6wXfqQraVnTGmup7YnMxbQlPbjhwtpLt/tk4Ww0rToCQLN1VHCx0yzDUcyHpIRqdkmyjWUYTW0I3BUhOU/vl/CartLt03vZ9eyVaSFaNoo14EMO9+fMyT5iED2hZZhmtIjPDEtQmwOzfmVpsKOJq6muGldnU5q3s+Dp1Fl0KL/3Ja51GObHv1FAAUag9towPUc+qcbW8fSUDOE0CCkAbNxGBJ68CsicIrOr+72/ZiExSm3juCbf9/A2t385wZtnp9en6EPZ0VocfNgt7llYAofLPxRzq8F2QM94np/Ji4Y3YHrRzRgV3bXn1OF0ydWuXyaVXEKNFjS4BYENYH3Wko7YCpUIbv9/bjAYxD7n7hw2ZTgxOwqFSzh9ezoVCIIrZFEIaolmE3RqGl+ZVzp40JMoD/NEA7X8vtTg7tpMyIoT9AolwoG6ekTQcNHkvKJUuRLmYd7BG1kbBCUoNSvb0T6u2CuS65s5sLYFHG1eIH+pOEsTjsHqUSbzrRXWESDv7ZqgHlJGmnmQJyFgb2e4TY3Bn9t1Aw8ZoSNrWo+ywIPYdDydTBa7HLhSbx1xYWy1oIVQwiuPc2m+ekmEEqKZxtT63jz0425u1QlJd0hUdFCvJ+nX1CFXsu2f+d90x5Nn3DQoJiOtFy2TYonPIf+OIXUnQRcJRWfDrqkDN3kIo30mDeQQwph6CC5jZSgEhzRzAQXksQYwKAhO3EIyl1pWNMEem5Cn0s/LOTi67293R1JsIiH//Pnz/Z78/knxbOZlXdi6Qdb/Vcf2YgnWj1MCskM20wiSQgrrYhfhQoJRhliRBkVkDOquDb0jGDkPVDuEq8N9IiWwJbpgEcEwYVCpbVGSMpFKR/Imj+voSeVIgz9V8tYb1bZZAVQsEoCIOQ433Xbv+aHxVKfF2MsPaC1kgrIuKoWkEsxnh5xzs8zPJlhKac47IvmX9rpis5fcEBHFXCp4cpgUsMpeAKZbwm5UQEiF0+4ATdOqdfWXTEXNl6cKTOw7e1V327t7e3OAAuW+yrVNZjQbg7za48Yrc38YnOqq3Dz42r6TzHwzPn7P3D+Gp0udIeFs0T6BMJlhZxx/S7s/CDYx4QhBbBHVpsX5i4Z5uvlyj9VCyYzyBqt0I8IeVKYITLKVAEPgG6evLFv257uPgaCQh4WUxQrgnpEjQlhJRVMjbkxgiqUDJM5TwRJuqu16a4Dq70AAsS9s1I1DbKMeBkg8575KVjaGc03GMs8DGb0Rp/zMOpvQy/SRvjFtLQ2GrSldUIUESPKSKK1g5hKktrENgwJ19b1U0RjyLzfp/d+RHgGahO83tkxj5gnIi4GWxG6FhPbTQFnmeD3dGRim6jU9p6koyydIAVW/axyrZc3xT2SSjSmaQoqJpx9Y5KmgP31RVsWgirmUX67UX2MVAVGei8nOBFWxR8dmG2+uIUDb9qAMdExN68rVW0D/wPH5yzGjuFWuSn8pHBuWIPDqCgT9GeOU6Mg2WfAPLOGYIEATlOHtbnFIfcxyYyaMeTagtSv5Syx1suMFIjAfYKdyymw6aYhAM+vrWliJBz8Hhu/svfHKdf5GGaOMWO8OBNLO6wWUKDwikwj1COpScyb3fDV0qEsQULaGhcSlioaTewIZosYGYGlCs5067Kxo5TsYsBV2ntBL8Mcv8q814xk3muUxFCttJ0L8MwJYY0bl6NTjLFhHFT6cFEC07RwEMzZ1lgufPWueNYFlL7CwUD6fRJDDpTWUg0TWcpskuuL9lbNeK9uGR8zTd5iTfy4RsDWnIcYRGUoDoINVOFAmZ63cIYFXX9jPgIe+bbPDzg75h0dxUo8/hCB32b4KZdErDAU5qOdrtKACGGyAzinffHNQ1574FK4srG+e6e5IsqMwq6FC+7x3AhdeNTYptBKmdxh71SwXmKwaj0X2a7Lmn+G+I6AB41A+BIXgSuOKUGJtGorTAIiiQuwhBm8RhMnZdwFA2YIQ2EVay2b0yMQsiO/sAu0UB5iNiAyWq20uAyW2HjvuZgUJAdVfEQgZJT35+mNmKGL9vGVJu2xYfa2HyoUE4u3g7E0gSTJFTJ+OStz8Zp/Fmx9YH/h8LXVN+nX9HklC2WkprUX36Ws0k4+TntEKHRKmVSEsmXJCbtobfYHQLMuG8SQhq7KuzR7He2rDgJVbPN6OZGKjHayFCst1JfeRwa7FR5+4aqbyZcFfapkyxflVbsq7hgbmu6CwjT9Lx2sfYg2MRKeIcw4m4zoXwE8Zrn8x4+S9PNUb/Qb/VJEkxvNy+aDRvjGK9kxZ33DFzgtH+YsqbBbckmS5dm+iuHjck7Zl2B2d4Mly2UYFgK9mpufA/jVHwmdIRfWJuYCpXwQBBvIOaUqMJwJy1JP8HRlJSZ8DT0TYqRnRtiopYoWcsLqnxWovvr3xi3tYYa7OBlRtlFDG4KAAc0GXT3oEhXp1uUU/KJaog+DcEbU2hz+66AcF1T5xtTjAvAXBflZFOSCoC8q8meJ870pydP4fVNqcgH8i6L8JRTlgr7foKpcAP+iLC+jLBf0++HV5XXRkMLYxO9B8fnsAnw53chh8SOpPGWcvxlNpgz2eigoDqYXvWMhvcORbZ3UCd+P5dvWEtCCsvQZFAkfxfaj6wcKiwFRa3OKrIN7yZJkXTSnxaD+XvSrr+xYstT8kVSsZSjzzShiyyC3HuraYpC/KHVfwplkibtOqt9ikH/jCuJXciNZ4v2QOmIY6NjFgyKTMQh3ROH3jwp6NCO50EcG9Vegl8CImLwajHqCj4OKFl4qXA/JxGbOySEfI30SMjQmPVeaAfLs9FCUDYokHVvAJffgugSZxeMUE6Kn+VrHgp29as3p1ZCzWfb9SoAWpK4ujIj7WNAZYL+TzNUpUR5wX7fEfVWUecf/ommKd1pRHW2aNf3f0cnVR7u+6H0HNZrdhgn5fodj/cU/t9BxlqXkd9L7B1U7+/VW1IgarRKwm/94e/3uombe+4XEt3zLFbDaaTSjOnrHezQlO43WaWPv0C7Qzn59L2gv6pdJRn08oumqEv3ed5CZD226CHJBkiFWNZSQHsWshvqCkJ5MamhMWcLHcquSuObpSnx+vGz596Z8ExtYhdkZVSwsW+GbtwkoemnMhUq+Naz4jv8X381KIS6jWyIYWZUpPYObmd2jY6pS4fFDO3Ev2ovq241GcxvKhdO4CqvvwMhGz8M3ruRNwDUPMco/q6jpTLavxSVufitnYsIUlzWU93Km8s/JFizGFfczXEYa0JWZY9IkAt3YeW3VILDKsCIDLuhf5gk+jTxlqijBo48ae5T3BMcJlAAmItYGFshiSmRgq733j0uC+jxN+ViPbPtuF1U2IOt409fZ23qNUsry+xoa4Rgozeh9kdRm6T1b8uh9B014/uqV0BoRhvw1SIeyaZu20ENKparZUjRBPpwpf+OHzHiWa1s1idBVSrAkKCUK5RKyw1BvognF9AyYmbLhZqrTk05NUzUTPOOSIBrkYuMkgV7r1flNgOqiVgiX0WpLQs7sjUVEZ6MeNaoUh9WiENTnfIRKqpWgktFzl3pVwZo7v10cXz7W0NHPOhMHiyLf3pr0E3RYb0aNP5HCg025ZZJ0MxzfEuWL+kmTR4clomwAZbygY5r5E8bHUvKY2gq7egjmCn+AjwWcMJoCfmNj38rATmYOdteZ3e+0S1P/JNI0qMJCkJiLRA9H2SC12Co8gHRekC45lDaCdvCOiENTekcD+uc2Zdt/IsJinMncQClr1kVUBRkq1TRRk4zGQV6xzUyD8mbYF2+RhEku0CaJBhH6g5DbGvqdCiKHWNxuQUUSekfSCfKmMTgQBe5Dp4cpSlDGiJi7qmYIZB6yyBULLNGmy7mzo9rfyvhvzUHyYfQMfnbcRbF8AD0jLe24UJHMyW/KvITTuLMKXtGMbrpeEkcOhQcDkBd2yPc91/42YG7HvVHI5fYUqeA/97gd0vN26AKEemR+V9ham85xmFAZCwKOyukdZscECILx5q1Lnwoyxmkqa0gA88ua8UzhBPVwillMhFzC37Ay5zoget42xpVmlaI/hl+Valm/6Fm2QpfE+8xW3gbswF24KH48V5Imj+rk4k+OPGVE4B4N6sy7I6Tip4fOEn2UlIZ7RMYwrgQAzSQQ2yKWJTfiAgm7Rp1cabEfaEDK+04l0aeFiIdUEdPZFRBUMxTDEAgYXKZeg5pqy3c5nX/bS4/NfniH1gafgZ6r87FzuqX/MO2jUnjwb1MMU0NtV8WYC3RmpcJWqUaAycWXr3d2/sxxOpGDHIskMn9DD5I/x6Q3JGm20+ddqGCX7mhtNCXJgOihd0oIdp1mT2Q0VKN//woDecDKxCie/c9WZYUxV/XRZXlXK7Gv/r3hcFsi3iBO9bHkynysiIOgJVZpYl/qvEQhGUPBN8tkpYUre9TComnQXi3iYrAT30m5U13q/rfOwv1BAgy+cTfDzFoEX1QvBGxne8ZKr3LgFE7v6XmrRpiz6eI7Eo2oEgTWC2TmTh//CZsn/Sm+I10oldANAJTdWBBtJP77BFrhlKYOZTolRoc4vc+41DLp5LfTENP/VHLGOdNW4fsOMj0BUTNqNKP9WlgQrEwaa+F+uDqJosUDy6FL1aq3npPdwZ0maHPmCp/KB5auettVLeOcfXe6KIlWpnVpyjiKWKG0ed7ecmVnbLuyUimn6sMdMNZsG6HzsCgHystXyXYSO7CLn5ilfXngxbbQeIhVl8qu3ko02bJ7ZnqflGaY2TPn7f/MWctt05+yXq8v3GcQaneT1XVHOUaCmEKj84VZybawks0UVx9RRQfGNCzRyi2a30nJ1PpVEa565eIB3e5Rpr8Fz348oP+j//jZ03m/0ViCzJphuyvdRNYC5wLJGLNqdp/b7bRRbxxGyzCUnosREd0RlvBV9Z25tmXM5qkvABIyIFWifE0Y7qWLN6eMuSBRr9yq8HOI9lOO1VylvqOHNHWKBGYDe/9fj+raNmnUo7qtVKb/RD3ibqVGXCokyR0RYQXfN1rplnZErq19rcNKSaQcQcABnChZyqlyxBoRJWgs0SZWCse36A6C4woPtCmee0/VpIYyQe9oSgbEdk+wkUyKCNNMYquG6CjDsSpGDeOS9Bh+XP3aQMCweigbxQgwbdnOCjHPyBwFpkLhdMYLsPt2wuNco7xVqbu3otZyS0/YHRWc6ZEXuqNfIQ+chiB+jhkwmyBfMhq4x65cDS2zchCtQgXR48s1WzpFRhkX67pq1xa6zy0YXEGPsMrNAmhSJzQoFVksEwT+2TWMn3cfLUD11d53gIvk0vWaK3maCqfE5uVv7a1CEYF6mworGlzaw/IAP2N2S9kArhY2Lvh4o4Y23pGE5qMNw/0bb+lguAFLo41bdNfUi+3FsB8ROEROO44hVreYS8FUxVi7Ud3WZpyA7zchfcoCklkbqHi4tHYBd8ETVCI+ZiQxmhVmeGD8f2fnHzrX0XsxMG0H0SZ8oYUw+tjZ1rgkiHG2nQneD9oHho39amg85Fp4UOk6eCiOhiTN4PyAmxBJYmBarZmDXNGaYcZZwDqK4JFEOBZcGuV/zEWazGFddpdEjEoVDfgdeIO2regCNq4WHu6CaxE2tsuzQs3Gc0SldgMVDTVlQbi4gxZDp19FRDl2DOkzmwuq7EIhQQZYQGxLIDqWo3ClcaKniksgfNZXfN+qH5VdxNBL8KTotfoIL/Er07JcQwGHj7lrM1aX3ojOaaw3FwwftHKVpY7voTeZmp5k6QSlfDCwfbDQ9UUHaaFsbuwSOqBw0ro+zUXj5b856pA4V1rfRD3KsKBaf+rsvDt/d1qejdnclx5P4Bk4oHE6kdAsAdrJOCg53Nzc+j3+u+s5E7aoNSHo0vTk0m/XoPuHjweAmNob/QP0kLyJYBg74hDLIZGOB9unH7YJ06dPUm4WLkiRgWKbJuk3b6CVHbTwKV2j9UgRbuDvd839pAFEvxzJIW629m+2PHqnd3ZRsSpC3gMwZq8A3B1hcYEqa2VQHClM50pDj7Cis70i0KttHYPoRqUyCrpu3tjmV3ZE+DlOKWHKEnTx2y6cwibWxxHkL60q4tq3MbVtjQM4bMXozc7x5VZkYlz1fBLdYTHRJ0g8s1XNP76TvFFGgvUCV1gP2sDrLQqxz2ZVizZgege0LztomgoIberhxjRNYiwSac2FUtoYqW4a/+rvQW+PhTUaiH0R69GE3Pcg10BpcV7dwb+KCLbJMLRmR1p9QcdS5gKzmKCOuSM+Wbhbu6fNOjQml1VoL96Z3OK0js3IF19104vc9xrXql4Nvf/4M9RuL8pxQ/fxBziktA+fyi1r3Yj8nWYqLZF+o2S8JILr1nt8eWFxzuJnoMEatiBfnCRTO2ZJsvyA7coZV11o+LcAqklZpyjbSnREEPRbpPFwRvk1DYZSzgYEwugkTaDHxR1OaTLHD96sb9cPthv7qL77utF6vXv0v+r11/XFw3u46pp7zFVhCj6cx2LZONquHwKWjdd79dfN1nJYmjYK3Vsy6eJ0oPfLcFVh68duPh8OZwJSVKkHxC2p3sAfOgsfWAGucS7uVrVZIWBDz2fwtMFaJE31A7H9qcAW+XUoWbR2Vln87B1FlfTRNn/Wai58FRQQidxnnC3WWnSqa1uJDqd2uKJnFxHQ3KS82CaI5/GI7rdauwfhwrCE3FfFbSyGvKR/LcIgDyEO7iD6l7/oCtZaZjjWhi/qUVVt7TTre4eLu7sExWnX3BauiMttqreZ2t3LwzHpWb76dAa3FQg9qQiLgzsKAKBvoyqgkQxwRTbEEPlB4xqiKsjKMN4DZT08HAzTVCtOcM2VZSZZozR80Q+6kvit1tmbN0cnB+3TN2f1o8P6UbvRPDk5XljyeLfSyoXsebnIRLgEHqhQ4vxOICh5NCJwNVjutYiseuFcY+gXji4wG6ATMckURyntCSwmEeoQ4m/qB1QN8x7EAw54itlgZ8B3einv7Qx4I2rs7UgR78QwwM59q34E/4kG/KeL3d2D7YvdVnXXRm3WtPa3lzgGrHNkPVwA0vsAHFhVyMohFiSJBinv4dTrrYwsXAWgwH0dTPznsfAdTuto4k+LPeeY05vyQRu/c/1zoaPX0MXPHczQmbbeqYx54AMw9z1g8T87l6y1aV8iyLIYrptt/5AQKC34cyC8hoZ8Bf4LofcDGuT2cn612l6Qk2huXkD1qmTb3c8htK0heY16BMI0MIuHXJiP27GLUrZ3im/MMyVQ/g8Y/8T1yLRnq37d34+5qy24uU9T2zodrj80yOEtjr/BgQTLIZcqOEiq6FYBi/6nTTJBYrgg24ZLKjOgq/RjPtFyKiVm03mdb+387iixP5cg1ZhGio7IX0XdFAMoTqnv4Z5hNXxtc2SmHh7RgYmifo2UyEl5dEOb0rActpr9ynzoLkAZv1IQeAbBLYNcwPKYyarwm1mEWdz0WoXPPYgWDFq5urMDV7LCg6NrAmtDiMiIMqkCl/tn6QROIvMucu8imrhNEqc8T4r9cKI/uigYgUZE4QQrXL1F3tlfTYhTXHoVQnkLSw0nSRce6Loh9ZMxkdKEYoY7poQ5vBTRER4EteqLylUjuo17cdJo7u49zCTnegR03vZBwgZcRxHLIj+hY71a8BBPk5BZHUAa/shA5XD9zHJXPvzgcgdzOACLoOGHp/EI+ecXnukRHDw112PZOJhthOMhZaQb1IB4eDL7Qlg04rFzhbGI3UcItYffeuysmeAgyR65cPbxxddNkEGhaz48R+nRyvGdWEh4fAu8auVC232u2F7mN9BU9GmZpiTWpxQIBfOb3uFyyIXqGulcaB7ucDbzbXuZMOcQ9WChxVIyzQkBtRJntJaQWAHBql+pJNqcqbTEWXw2kHRTtuYCs069+bhJl5/ONshHP6Hr9+33r9FbPtYayAhnpubJ/8zAUjrs0cMHPpovz5GX6QaEyHGuPlYLvn1rPlUMcs76PORWeyxAG3cnawIG1d9Xsqc9N05POmH9A9drXEYkltFklEb2OZNii4XxUjPOtos3y02ULSIPc/r8pSlVEHVD9DhPCWaPJG+/oAik2hXLPjsvl1Evp+ljjAJ/em80DtuN+tHG48B530EwQxjVVQ1IzBNSuQ8egkUqQVQ8fDwwbhZTbplNPAfe5j0iGFEQcGL58B/hdxXjFr97nausQBWDopALH5aqxUuflawloB/muWmKZzypFjsLbeaAAhk3Tq/ZxdVT5RUyfNmZrniCPp63qyei2cw8pa8eP8X51ewMYMZnOH4+shUjzk7Gk5lD5YmTuaJ3cyabsm6ePqEbsKpOhZ7x//t//l9pq9nNgmTPiL8/+TQKfu6OcJZRNrDPbvz9kaIjwMmeniOczYIMxZmNL2/t4A5gqwZekhQSxtYPdA9ZNeCCZCmNsZytCv007i3GnbNpEpKlfDJyLp1nm7gYd87E4GDs5+mzoxwMPGfqz2ity07sh7U3JwntQ3azQmqITTg8/OCrIIucKToiWyvS5hfFwkxtlQt7jheaxZX/omJc+2OhU3iHRpUOUIyNFlIAyP1jKWNniIrchwfMDovxf3nKbynexrniCZWQAleg/3+aX1Hb/jJB4XMo8Lp81oFVMVSogVk4/JDzHL32uch4+cqZbgt4NZ1D3AY+8L4HIHDpVs855XF41HSnOB7aEuBDXCqPYMPLYsxQjyBC1bCga4KS3NSEUVioPCu5lcEI4mJkqjN4vyxkY2RY4BFRGjFhsyBh3YgCcyzSxof5Qn+s2bR+AA3yoHCqh1DSxMKcX5knLHshmtQgUQXSIksgQUKUkkCZahLaPI5M8CSP1eKEhOAsv3ftMNpE8Lg9NO3S7FKa9pX0VSk3g5m3PjN1kKq/4MzmXX8/7dEPeEFqMQv1QimrhiMX6XKzf/xwgYZ8bOJ6zHSWWwGSh4ge54KUd0vZRJ4z6+9DAtugwG+MpWdx607AuRoSpnxNJYEYV95KBHdZYu+orDArfVcxdcf/jkY8yVNTypUw47D+2L5CIyIlHhCJNu3NmLn71srz68NGs7VlkqVMJVqTDmcm1ZyqeMzTyPjfNV39YFgAKe8IKN2Km9sOm30F5QlZ+d4Fcg7vcIokYfDCqcmvQXGaS2UdCFXC0wCz8AnUy+PbUlRCZRGmOQWYituEGOJJZFReuSjDUmpOjPqYprkgEeNdyCvqQtzS4452S2YL6QLm4OOQSHjcNfUgZ3978Bb2c9gvgd1Dlqg0WXePUPYeB7Vbm2XAdLDMAGlamzwbjJZtlgHRQII2Gc9ZhX6Kn5eYT+ZrCxDazLBUSEumKq0a+HQG6IcCXstTmtBN57Gdt6Omqos/PUShDESp7KKhAewfIhAa4HxAEBpSqfhA4FGFmzJW96uzjCcMj2hs1epHYVe+U1d4IP/2/wcAAP//a9nkHA=="
+	return "eJzsvW1zGzmSIPy9fwUedcQje44sUbJky7rdu2VL6hnF2W6PpZ7enfaECFaBJEZFoBpAiWbvzX+/yEQChSKLsuQXds+uoic8drEqkUgkEvmOb9lPw3dvLt788f9jZ5op7ZgopGNuJi2byFKwQhqRu3LZY9KxBbdsKpQw3ImCjZfMzQQ7P71kldF/F7nrffMtG3MrCqYVPr8Vxkqt2H62P8gG2Tffsrel4FawW2mlYzPnKnuytzeVblaPs1zP90TJrZP5nsgtc5rZejoV1rF8xtVU4COAO5GiLGz2zTd9diOWJ0zk9hvGnHSlOIGBv2GsEDY3snJSK3zEvqdvGH198g1jjPWZ4nNxwnb/zcm5sI7Pq138gbFS3IryhOXaCHpixC+1NKI4Yc7U4aFbVuKEFdyFB62Rd8+4E3sAmy1mQiHFxK1Qjmkjp1IBJbNv6MsrILu0+FIRvxMfnOE5UHxi9LyB0GNuWcmcl+WSGVEZYYVyUk1xIILYDNe5dlbXJhdx/ItJgp//jc24ZUoHbEsWydTzXHLLy1owaRNkKl3VJUyMwNJgE2msw++TUQAtI3IhbxusKlmJUqoGr3dEd79ybKIN42XpIdgsrJf4wOcVMMDuwWD/eX9w1D94djU4PhkcnTw7zI6Pnv11t7XkJR+L0m5cbL+uegyMTY/8P679LzdiudCm6Fz009o6PQfe3PP0qbg0Ns7nlCs2FqyGneI040XB5sJxJtVEmzkHngVOp/mxy5muywJ3Z66V41IxJSwso0cImRrgDsuS4XiWcSOYdRqIxm3ANSJwHkg1KnR+I8yIcVWw0c2xHRFZOqj6nzu8qkqZI347J2xnonV/zM1Oj+0IdQtPKqOLOsff/9Em9lxYy6fiI9R24oPrJOj32rBST4kkyD8EkXiCCOP3DrxJP/eYrpycy18jNwL33EqxgJ0iFeMIFx4IE+kDw1ln6tzVQMFSTy1bSDfTtWNcNZuhhUOPaTcThsQLy/0i51rl3AmV7AengYXnjLNZPeeqbwQv+LgUzNbzOTdLppN9GHG6mLB5XTpZlXHulokP0jrYiWLZDDgfSyUKJpXTTKv49vqS/kmUpWY/aVMWrcVyfPqxfdHmfjlV2ohrPta34oTtDw4Ou1bxlbQO5kbf2rgBHJ8ywfNZmPEKmrs/p4zlue1g529tBuNToQLv0EEwTB5Nja6rE3bQhdfu1Uz47+Pa0TYjQcwZH8PSwz+tnrgF7C4Qtg7OxQnB5GoJK8Edy3VZitzZHiuE83/RhumxFeZW2MDEGphvpmH9tGGO3wjL5oLb2og5bHwCG19b3b2WSZWXdSHYd4KDnMD5WjbnS8ZLq5mpFRzENK6xGZ6DONHsDzRVAmlnIFDHopHdyO+AP5elDRyJ3wJcBbsHpNRMIG7J/AyBXMyESSX9jFeVAL6Eyc5EOlVULIAAKvLoRGuntIPVD9M9YRd+wBw0CD3x04atBFvY9hoMM2AJRirMWPDIUrCvh29fozIjbceUaM15Ve3BZGQuMtZwRyqfCy3CCqFgRgWFyQloAhzGhtOYuZnR9XTGfqlFDSSzS+vE3LJS3gj2f/jkhvfYO1FIizxQGZ0La6WaEuTwuq3zGeOWvdJT67idwcvDt6/ZJTCUiUTzWxNZnZ6kqk66W8a1LIssyLH4c9d+37Tn79z363vs/IMTqoBDHoZukXJCHMGnqbwjdQhnAPSUigA4HfcnV8sOeLgHuV8Ir8VEkLA3KqNvZSF6oNbYSuRyInPgojl3qD5J0Ei8whEp25JHc+GMzIGvooL7InueDdgTPi+eHz7tsVKO8Wf/+Ofn/OCZOJ4cT54NJkeDwf6YPzs8FIfi6LA4Ll7m4+ODfLw/eJEngzGcl2MHg4NBf3DQHxyxg2cn+4OT/QH7H4PBYMB+vDr9W/JBISa8Lt010uuETXhpxdqyi2om5sLw8loW64suaIm+8MKHMZksQGZOpDBenkhL++qJnOBBhaeZfbrKAhJ0HzNH3TIYAjw32sJCWccNCNhx7dgIwWWyGOH2BI2pewWP+SEsxGSNQLLYxl74UclfavEp9CBZeIKSzMs/pOMCNcSxYMB2mSzunHaxNm34cxsTJ70YhmsdKWsrbhlHw4zOU6/ZTOUtWFAaVDC/0v5tUnxmoqwmdQkyGCQKzToCdgvNvqfzgEllHVc5KcorB5qFgfFUA6YiLY01WpqouMFDIMKWlikhQLppxRYzmc/Wh4oHQ67nMBgYc8m8LyYgj8LBhVP1J1p4pCdOKFaKiWNiXrll9xJPtF5bXZDe21jdq2V1x7LSMxyI8XLBl5ZZB39GmoMRYmeBlZEGwSZEeKgwhrOcgToQVIFI7eZdvyVooLFoXkHdSE5aDBFhrjFGiynmPJ+BYdpN+lVYgf50SGxhCf5Cx1F7ETpwfZ4NskHf5AdtHdquKNC100rPdW3ZJWoi91Cmh4rx5jOvwLAnw8unwMM8qMaEZK6VEujauFBOGCUce2u007ludJInF2+fMqNrPJsrIybyg7CsVoXwWgPoAkaXwAUgM7Vhc20EU8IttLlhugJ3lTagb0eYYzHj5QQ+4QzUrVIwXsylktbBzr4N2j3oWYWeg8WNgoicLH4i87lWPZaXgptyGUEXYoLWV8RYlzJfgtQCZCVNM3uwxqbq+ViYdR7aeGiXWk038QkdQB4m+FI02KhFwHJtAUkJjo8TuEFJJQRhqd88ZTUOUC6bM856Cy8uC1BUsIsVerSYdP9o//nLNUJoM+VK/ooCOOs+uL6UIoP29vXqiqTIJA4LxjZ4MsJ/oMPYVc3sHsrbytr9kMwfSdJJuz9qPS0Fe/XqtLXD81KumcmnpbynnTyk72ErBz4Hyw0ZWzoJ+8xvqrDMtMFJyyegZP8aMeWmgD1iwejRyvaS971FNJbeAS214iWblHrBjMjBeRDPFtCErk7fklXtz8wGzTXc4AG8nmCGW9sKFa1heOfyP96wiuc3wj2xTzPUt7x7pyIBtTaU966CktoalGBqg1aFAKdcMDMDlZzhynKcZcYu9VzQnkIPCb7phJmzHbLXnDY7AVPNjJgI00JFrUzQ+u1LP5OLw/PUWEQTH10cAewsoMAALTUNy9wMkeKPpM/YaWsAOD9rW4PWTlAb34JUgN7fa4X4eVcD2NvRe9YFrKGv0m4NJKh8fr36KAWIHyKbELy9ME70mONG8kokOGKtmHPlZA4IwiYGEnPFxAdvefS8ekdApY1ap9MQ1Kh5KX8VwYEPHl2WC4O2qpWu5rQcFxO21LWJY0x4GT3R4awBiTzVZtmDV4NaZJ0Ex7eyNfpWeHTTg+pUCOuAPYCkQLCJLMsoBHlVGV0ZyZ0ol5/gMeBFYYS1X0/YtkUN7gJcwsBzhABpZlH8zMdyWuvalkvP5fhNBLsAclk9FxB2ALeLRQfuxdse4+Fkh2gCHFgfmAVnuMsY+4+G4lFTTXU1WF/DFwGnsB9GGT0Yeb6NzAe+DKHA60RQYd/V3pnuPRqjTFYjkHijzKM1AudhJVRBhgmyHVjJEST6sLLd9ZWy2aOyQMoCt9mjvvAJ+kKK83jphL2n8bLCR96rtg6iheB3AN+7S2OAk/Y8sZgX2d3Lfny4hrDfQPfE+HOkFJ0pfrxsDY+p0Fku3fK6m+u+PDrSLTev8GuwkwQvu9HUEDoWyl3nutgGrlcL3S+FcwIOwUK0A9gRm127eT5vht/cc0N0T3RLi/Im8XXFwbsnpI2bseFcGJnzDcjXypnltbR6W+t06odkF5c/4EJ1Yn46vBPdbbE/obqRY0654kU3ZUudtz16d6M5Ffq60lK5Tbi80moqHcTmQDErucN/dGK1+59sp9Rq54T1XzzLnu8fHj8b9NhOyd3OCTs8yo4GRy/3j9k/djsR/7oHWmtOuz9aYfpB8Up+8iZfIGGPkRsOCQi/TQ1XdcmNdI2bhCLWRvjQaqIpnQYFKTo//e6RxntQcwE+A7K+JqXWhrQJCMV6L3qwbZojx6NXsmq2tJClEqO3eZCZjUHJ2BvtknQWcDqChgeKzhw1n6nQYbbZbteajrV1WvWLvHPNKm0dL7e1g3ff4nC4exm3Vueyie8CjeNUGgL8hZJIGpsBAmQhLQwCcjHIPBbsRumFAguRM5gaDqQN++vFW9aaI2wFVMhvIclhIQtRLv2RT5ICVEr6azddXx4ODgefIvaNmEqttik43+GIH5Ob/T+f3oXvliQn4bpRcP65FmPRzctgV/2q1TawBOsOhmMwXjhSA/P2Yoz8YvhmmLy3cVJ00O4NDXjJpeJ739VCaXs9lEbYhzKZrO5JAVltmt/F22hPBn3B65dPLt7eHoJtePH29vnTbG3sOc/vOfjnkH/39fC0G8FEcsIaKe1iXH/OSal/9/0pezE4PACfHCV1Qh7lOfiEde6EY0/QDQeZEMf9sWxOVrAdMHgQVUTKFFxo9nNdVcJAEOhvbCY+8ELkcs5LVsipdBhFA3USMMW0uAiT0PcDg+BSrFZWTiltSkyFydhlnWM2xi29SEl1PvrncWiUidmymiXpKy1OGwz6g0H/6Bz/fNY/eLa2ggqCttUDzvHNXLR7Zbiy3id28RYWijxEPjH3zfAqulvZE5FNM4pJ8JJWMwJ2OoYrWiH5eDAmHkbmDMcwl5qyUvOCjXkJYTRje2wijViAgws9uhAXEabJ2mwTodLGPYAMG8xN60yTQ3MnhWC8fyYaeQ+nXSfRfazxFhXeekifbHcfrOPWuXYPcRPcvW5vaa1SwbOKA5yb1gkjiutNXoCNzPRZwhEE30xOZ5Cs3iAR6Olx6eEEqwrSBCaewPU4OA8i5O+b3AWv4ybgyGMJuhhk32b0HmTO74CI3EkfpPzYZGpTsgQkJpo5RiorI3JpQRdDlZB7LylmqMHwVT0uZc5sPZnIDxEivvMEEvhP9vb8K/4N8Lc9zdiVWQKfg/MclMgPEjRnr0COl8zKeQVxEn6TrjeMBun/GJn3acheX4QEO3QELkRZ4uyvXp01WXE7uc7qm51st4tRE4qscUsk/za5JA6KwieaT5MaIhu/QABhIpulBrYOeZ7NtodkTWIheAHygHNRebMLE1fgaRIjX9sWGWZPcFZx42QSomFrGKDQRsPKW2T0u9e6GhsPfoIpIGUhmNLEaFib33oJBSiPz65PaCwg1tfJ/t17hblNtN1ZLBaZ4NZl8yVB8Azjdwy3bqcRJFQCQVCg+CEmXeNcIQ+nGabROndsPT7IbD3eb23KXgTcRs8bURQMICokMHZ6PiamNBwasoStVAkj9YbELpjdQzVWp6trnNJvICnFZAKKw61gTlfERESZJ+Lq1dnTns9gjpZlsyYRrhc3vRDkRcEB7Bz4iODBZLOUUbxQXR03gk1Sx2AFAfzOP7c0RUm6SZA2K3F/kYq/rfFTbYWh2NW2WCn1rfo8AW189B2QgaXjbC4wbKUn3WKjB7r/q7PhWxBzQ0+Jswgq5aHd7hmLOZflliYMDjiGAwZDbF0DQqRAEm9wu/6XjnUBgXZtcxChS5DfcllC5mbWxczDciyMY+eQ+Cek6qYnBsh/N8yN2GyHu3GobGvJ4uuJ0aE2ABEJgWAfRN6rSu7AdNiwCfCTrxzxbWGfrpgfvBuxGbezLaEUUs2BIFDKOfMuVmMEeAjWqjA4CU7FuNJqmZbNeSstYbMfraBs6xF8hFn3kL+A/wCqj2KZRq7VxCcC8rI1JniE13VFcLhvYsitJOivsyGtKs6tG7FuPvsSqP0uJe3lDKxxGB5ES6mnUnUTKRG1HEXtN6vYI1GNLoVdp99X2RRDYzgWWcLIDEcOMSv0G7fLLzsmsvvzzo0cc8WvMWsYCmCNQGtOTa8BaChO/AhdAwXyUtfFalJm8+iunMzvYa2gDqZMc3IQICyMVBPDYyVrMy3vy/SVCASWUUXCXfV3E/a6qX2SNi2c4FDxf+CLAWFbT4TLZ8JizI5GAPgM/LLwUsieBERBDDW24FrRpYSaRp9430aB4JpaUTWlEXPtYpo+07WzshDJSKuY4ZwZZ1TsFyZEgCmtCz+leGO72Bh/SQC5WTN4cKDJHMrjG1SJYJ+SgpdjcGx7x/HuVUM4PzbwVJpQxWQRq3hJXC5ZIScTYVI3KfzgIK0Lwqg+TarvhOLKMaFupdFq3o4jNDw3/OkyDi6LXkhUOkWsfnj3R3ZRoIvCJ+fWq5I72+3awM+fP3/x4sXx8fHLly83knmLmkMHoYNo5aXk9g4aR9pG2J9HYxi3g8qFtFXJKbmik6YCLHCZ9wtxex/Z16K419BlCclS3dHEr0L2YTKujyrKkGiJtjXIqUTMdZ4Fte2D96W/vx4fDbVE29uwFzQiuzgLpzHOgWRS5wRkf//g2eHR8xfHLwd8nBdiMtg8ky3uiTiXtFqwezYBzfBDd8HbV8HydZD2y+ojSCYkdwfZXBSynq/NgBrp/CYinsZOhWeXsGiJhrfxmx4b/goqRvOkW/TOl30a6KFSgj77jeQyjU75iPelC7y9SpnNInS+DJP8BNpATbvZEl1SSzeSBxHIAkXSRjR8YXuM/1ob0WPTvGqc4lQYg+F6XupccJV1EYYv7NqUIS6g1ZYmTBkyn3E8rOFPuuFvxM5BMw3VxWl3hUJCHcm0lnYWNNg4yQhaq0QHCZ4Y3yUHFZDAED0mpqhggHVwa9krPh8XvMf+ePqW/fH0nN02KzusKnauplLFLfOX1+zW4nPqdNElkHhVMUGfwd8J5R7N1NSqxybcTLkTPVbi8N3bz/92n60X1hDS264hb4S72ogVCw7S4C5XfrvLlLuaCStWO9C0vCVoD42lgvQ5GJrFoW32YGvCNxdY57xOl8VY61JwtYmxvvM/A/PkvIL5ouO1wQ9YjLJ3OrfJLjRU270P6dMpAHippltsZgF6enM+RrUcEMGjnzq4dPQzWNPgqc9MaKjF5lzVE04dp8ZLxptWQ7dCFTrxqVxFSxSUU1GKW9DynYadUgr2hx8umVblspvLcz3PYFyRfajyDGLzywfT3XFX223RfFgUkgpA13cDUBEq7HxIWhBq3fQHdyV1C5qCEZ6bZeX01PBqJnMmjIEq8ZgWm0K95aUs0vRl8Eqb2rowHnsl+K1gtUpqHCch8Qw/bT7Rk1X4ESz0QapVPhP5zabmM+fv3v3w7vrHN1fvfry8Oj+7fvfDD1cPXr8a+8ZtKzH/0g+XJnM34kuYrlm+ltDfRU8cO9Wm0ivtNu45TSf4fMuyAYb8kgIC4WlDEoAaFgSxQE3RfN0jUiIC/QS5cP7nP/37X49fHw//8mA6w04QD6HzR46T3UvoXOg9gek269g60Bm0lRLyF9iq3IW8z01bzn+HaexjdISGYkwICBeo30WQrWQNEKztRmnQoUTrEtCFvlMYQ4bMP9jFOCzJiN0vd/ChUPmC9O4+vwF3Us3bJ/mtMMDeBeNTyN5prBX4IuonyrX9TZ0ikrcW5R7y7/4EC8QiVUqYVf2s/fgu1Ww3vhz0M9h/uLnhQFlr3tmcTaHBGEGNuDDKdk7asAJ3J0Ai5Vp6IZSbJwE8dD37zN4I2pJTWy1BaYeIRrb7YO1QFls4GCjO1hBFFtk6JnM+3aqVlBq3OHis3fNIApP65my6o+gTv8gcn24J24YzCVc+7cgKafW6vQ9Krb63H+l9u4bTBWJCjWTXcNniUjbEaZeBBFRof2wJm3d+NND0OSqgcMo0jJV1ibsCWhKYljyDPX9toZZ33pZoZyAMLtMf7pRpVzORwgoSBvpwYl4o1LxhtV84S5VYUFPFdAzFIYjIbA5t/qLQulBdn7RfbgozwnZLXyV0qJ0tgaX4ZqpJgsnEy9anFLeb6LLU2Od3zpUS5oSN/jOZcAac/o9+6xH83Qq38hSGsxXPxT9GYZGw8dxccIpXBmShOTAoCLSooGVg724TtANDFgHkjFK2IkHEQWBSyUxsxl5rs9KjBZWTkHk40bUqYKXwYWiojhmvPh6T5XpvXOrpHld9qVzso9t3uu9moh/DNtzxvp9v369S36/Sz/A14QjlfX+LazxU7Nx/bQU3+ay1BrlWFhwMq325xjyHNEUmVQF+Ja9uBadLCgDogTknkVBI6ZXvqXaTndWQ00mk0Qpi3FKr3jpcSOi11ATOMwigIj4E1oSOkkaGvjmtXKoECsHFwSmDJXbjHb0f9dhoD/74A/zxv+GPHfjjX+CP/wV//F/4g43YE2Srhk2eBoxHvRGGNUffjrLQNN8KH1VuEx37/wgI+oAqwhtjeQMzTGtZiD2hQqt9v3Z7EcxeXhtw6u0Rhfu5EdyJPlIpm7l5+e3KL7yS/Yq7WR8qo+b255SEf/sE3YM24QOkMjCb48pdr4vnlszbaTwnsH/CLuVqCV5LkHIcIjfQidkKZUUw3cgcex/hvk90tiC4svcqOCUbXgC/5IeMYyUZrHlloBnNTNT4L6EKLCMfpZCFyz3jtbgWUcMg90Kim9D5nqf4vPB3N8xAwSbqMStcCnUhYjMp9Myz9zvYUEnm73dill34Ft/I2Aib6WT0dEQmUAoVR4ztEfEzkGyjDpk6yt6r78RSq2KdiVOQHcdFbqQTRnKYJDS0B63I52OMIm5+bEjYb7ZACjZlypP3irE/sNdgGqZd9Uf9kf/ljcbSMqye4IrtDwaJJN/pOqfTdb6PcZLyezxevgbHD2HZTWzXFsfK2Jvw12jNUB8r6Ae48DtVqmlKRDqdsvfqNbReBshQuwGtUZchL0RQsT4JCepPy5fBsPYcmkLt1A2UXoSgEvW4HUNtVQWLAIELWOhA3owBOilIjxnmvAdzM61eRyffiL4eYT8upYmFyBWDPcKxL1cKF04fEArNt5uZun2utHk4hUnsPIpLkzIz0CuR6XdwcQryCzA0OZc+xe22rL4GJ+8OFdO3wgBpgaDwdUtwER9F9YSxU3+SldCqoNQLUaRByR1oGr+DTLnjO3rbnYz9BF2XKwjT4kaw/naOHbiBRSSEwq/sUrmZACG2E1ROJbhhkxocJdluF1lh0PsQNBAz6em2ouyv/XCnsp+8HjVr8vSsKpciXMjS7uVIYEMjvD1fipq1w1ZA2Ma/Q8l1oY0X3VrjPwTthkDiqUa3BBDnwgIHvFIkWq0Sw0J3zC1pPvixtoP+HKe2gwTxaiY2NdZLBgDOhRAnuHhRM7V4bU3axth3jyOo49CSD5xrXLVnbDcNGMjQJiaBbHWObF3fg/VTEXZwSVOsSU/Sal8YsXV1A6bE4gGrut8LZI6z9I1AIqU/QemjgpYHCI7PMcXT/oIpsQkLEhHh0FxtMhih0h1GX6jJYAQLPULFY5PBxyaDj00Gv2aTwXTjO926EK0D+d+002B6xIUq6FVkHtsNPrYbfGw3+Nhu8LHd4GO7wcd2g4/tBh/bDT62G3xQu8FUH/799hxMsHxsPPg7bTwoK1itlJ8+0lkvifY5zSojbyEd7Oz1X592NdXDxCs8UH73vQaxaV2S40WzB250Db2chkUF6pwJqEPOvs6sv3b3wAca1AfrCHYuyUOcAPdvIZgguy7e1hqmrWO1kVG+Rh/BlLKPzQQfmwk+NhN8bCb42EzwsZngYzPBx2aCj80EH5sJPjYTfGwm+NhM8LGZ4GMzwf/2zQSLslzJFHz16j4Zgq08vrDZWvsLixohusBKOTbcQApNsYQc7Tyx+sAlBy5AhY48bIeA0S76GTJ16VJ2METCRcd0i7FmO3bGwTvRHmfHK8BNxxFgFhsMonEoiiFLSMAdDBPIAHY6tQZjQdBJkDx/YGd+Av1Sqhsab8mejLKiLEdP6Zb34HzTiv0kVaEXtvn+0qP7A9bLwYdWd333o5If+tgFfG3ua7i00FiWctwFcM7zHy53v2mFie+RZtfuWJL9F2gCsjKjx54gv01PkNVleGwR8k/dImR1Of97dAxZmfVjA5HtNBBZJftjP5Et9xNZWYDH9iL3aC+S0g+M4WxeHD2AZp8jKV6fHWEz/+yT8LQzvr8lRC//NNz/PEwPjp5vD9eDo+efh+3R/sH2sD3aP/gcbG0hRLUtbC/Pzs/ffhq2W1I5Wi5SMsAS6YKHLBZMg6nL5ryyIcUhVVImshRgnRXS3nQLmBvIdimfHWSNnX5vUkCR/TopvsqKfQ9eb5wNDLpGl46JnZ68J+P4/SUa9c8O3n/WZEWGpZ5OYE/ELc379O2PLB2WOW6mwkXXMZCkc/ofnh9+wgyh8RhXyy1N7iLeYuqHbenXMLNeKLku4EIMQE6Wog9Jl9lX07krkSVIbpsSydPPIMRbvpqcf7+Jw1DXeCfs9mdOw37GrJ9nz7KXzweDbP/F4f7RJ0xfzqtthi+GeACFCcs5OFnpSoq351CaKzI2VIywYv0+2PD+NdbCs98Psfpgc00kFNdXRipq0Au+UagOZXzi4JIZ4alJ5bfhugrQu/u44yNszAqMLgzrO2zoHJuyFD2q0FxgfacvxPZtdZzh0QUCuFKle1s/Nsq/zF2rOQwkTYolCiPfKsjNoOVLH1x1IAv3Dgb7h3uD/T2ogofmPf051DUb0ffE6cOA0AAbmsN0n3qD/Pnx4Fl+KF4eHOzDX4qcH718/ozz4tnzoph8AvNoI6dS8fIaFu4rx6e7d9DnSs/Lt8OLN1fZ+b+ff8L0yXbf9pxp2M+d+048Ot5/GJ4Hrzr+/YfoH/dqxM59iBMIUyi7Evx4c3mf4Addo0SVPOD5O3tzyX6pBQQfsKiKK7sQptlQ8DtdpUSWvJC4p2O2OHhapJqWIsJaQmYzKBKaTYXDORJYAvpkVCiLfclOYOzl6CmIIDcTy+AuSKFDYkbsx4BIhrCNizXqCCb2EODWJ+vwVpIc4eD9DQthRLOWsQwH4axj6T8dPc0eHoloz/yTehK213CoGMebvYgKnrz0FSpwWO/tx2UWLqDVihnhaqOS0cbLeHVTq+E8ONgxxeFGLMk3Q2syFiGsQTX6VtCo7TYC4yU7P71s9sM7kWtTECyU8yidUw/2vJmOF91hcLiIgDuAR+BTj+Ab7XB9ge+g2Q5lYWNdgsBfIkNgTA/eo+XI2NCxuVRyXs979DDCDZPCDmoBrdiGaQQSBnvprE1D2iZxpgfGUwSJFyxDz4EJtBpwGmcEvfG0tRLfBr7mBVzdtUw74VCgkmywDYhyy/LaOh26CWa7m9gwy0u+te4TwEo4Hkw7LhIRVDTt/IygqgGDnNIpSS/ebJxScvXa155RbCZJj8fBXxqmsLqRBPf3oIUSSv8ptKKwITEHsPJSLZAqBRhosttFlP1BFv63kTpb1Bg8dZoEKTgAkntEVqbEKmEg0yXd0RfoysSW1XrCTt8MX5+Di34sqGWhLm/B1ZwIuN1d61tKhbZtVOMaQUJTRpQ8mMFjK62KJLSVAAE2gh5ZUd5B4ielia7CJJ2MjX6phY3NMEZQQiVajWFay4U51U2a7MYlc658wIrdVb8Si/ug6MrcYtwQjgQkAlKlc2WCe5/ns9aAcMX9BIVeeigU0ubcFKLI2F+Fof5iFnk/jBE6VzWEHadMyWiozp2/f7yZubd4A9ZV2Kp68jlyDHl6bT4zwQthricln9otzWc3ZvQcMGoZASLaY8IQk2RjnmOzsFZnsRM2HPbY1WmPvTvrsXfDHhue9djpWY+d/dDN/7s/77w72+mxnXfDNNknEGFrEUhYSpirrxNLw5DcUpEiaUeVgXszoOqLO3KLtiN2jOo7hPEtk1Jg2PS4kk13Hy9+bLep8Pxgf39/jSa62lBZ/VUJQ7k7GopNChKf1OGdwos3UhVwVOHMSR1MoDI2F9ZCh/A08V9CIZ0LtCUh6kK41oPCg9BTDVOrVuHeScM//3j+7j/WaBhl9G+m+xjSgP05BhOV4t5qTuuI2RL2eJLD8Ksor9b84DvUaTbUJSmt+ugiAjU46b/InvgCpWcHYO0hFmz/4HnsBQx7SdvWF80hEw1C8DpYJmzOK9in3Aq2PwhFxZY9eX92dpYUcn8HnSxtye2MDNxfau1ECplAZeyKj20PumcYCX3dvcVEnZvLpjc3YxMhmqZwcE5qdSsMFbi+dz323viv3is4WkFy4l0Zn6YVxPVfK0jbJjN0FWs+Fmj+Hgs0I7/Eddgmn8RBmWw5X2jmd5VXrgmXf7KCwsVisXkxHisHHysHv2TlYMNYv40JRJbixzWa4XC43scsmPLXX6opx3DNI1qW7OItKJzQK1SxUTAZwRAdtdhLxB9HwbNKfCYnE5nXJTrsait6bCxyDu3lielvoTjALUO/8AjTOzYtuPqS/vtwZyyEllyDXyhJFA2i4FyHDcPQ+5wQahTB+8790kXvIbzuu3C7mZiDfpSC9rqI/wh/F9yCfeJ0hHgrLZSo/ypIRQJNfKJNN0Pu/ryTOJ7Anmv+ud9l2AWd/bcwZcLYm9tFvfkB7xpcw3qLG2s33Vkx4hKSCIserQhozcjFCfteTNhS14Zc363vwcNYLtEZbuGlNNbTwwd09OFr/pKLCLdQNkKZeNxWgzb3xaJBgLZZiM+0kFgZH9x2OD4cuTT/JxrphZlJHBpJ63hakR3qt9FTiG8XjJOnK8IkqraFxObIUYiz6En0N3Xuh8QpH3hH5CvxufPT+8TnXgvH+2lAgYzunCIG2YOjTxuTLlYSy4z4pZZGFHjLofiyzA2hjpCFgYdoXAeYKOSXwRUcuc3opRFoATyiFOHiHFFAYWAGa3pAtMMqIsjUk/zTTCjPNrjQEIVNtMhwaUO/Tw5qCkABQkBrW8rpzJXLphIrAk5mg98nBVkltApCS9RQVgMv/g6okv8Hr3zg4esIkQ4TmkIni+1ng2zQ5jC4xnWFx5JHDyiB4yqJtlLpB7L8Er08kaY/wj0xcHygIuPfo5BeVQkI1EEa3UQ4f29BEB4Glgg6iFm28Edb9OjgK0w6K8pJ2JZgpPs7arPdB3N79/nyxXMozwE9PFBWw0Ae8TW8ZLEFrDbXgW/Ailx090RtpSy2gyDB5bc2kHU8v7kG1adjsP/SvRXgXMfZM5x9jAPiisDGqEowRAGzhmzM36iyCvo30EtStSQyUi812ujeBPBzpqkyvrVSq+VYIs3+zm95VnI1zd7UZfkWboYS5jx80hZzt43sDWIuefQxMUcqBIk6uv7Hp3uAvBEf3IZCuuYuOGRsuDaFYHlxFaXikJV6CgdWyH7wmsOaqhEUDOiEhFdjxXu4vPhsrKdXOgpPPOfCHVux1Jq7GFWFpwAowmDxai49SSZB8AIoHsqroMYGK7AhGM3ZTFvXay7fCAESb+TFHngEM6RbwHnJ085t2J2vAZJrpSgJZSzcAswcnl61wSkHhcD6waSSDhrkFgArL7WFuQ3DSnyc3KA+xsNwDiFLVfsW4CUEJm1txBxJEi5rWqds8hpW1MCFkZGfUzKn7NHQeC7m0C8EDlYYLYArGkrTFSjQCJGgOjHHyAtcq8Mu6Ro8up0MzuIRXVSJxAxRx5Dh074WjyBGvRY5mzCFcaEybE3nuP9Jy/O4VT9+dHyOCNoFGeRHi/ZQiCBRs4H46VWrSzUSL93dlMTjv4I4P1xtCazRaNkzrsJtcHB79lSbZbwKM1l0ECQjJFOfFwXddwf7qY/7SeAjSJWkqwOLkQ8OhjBYhAjHDJozgZ1pZuBJRs4TG2xwaJjQr7i1INv7PkN2bZHCFLazTL46k5oIT8AmBVX51OMQ7szwCYjeI4FKOIdgTBrk8zYbeQtpyQBQmAybSX851jJZ+dU1azRaBM52xnLKxjWEe+0O7NkEohQrF2BGqBNZOmFIOq4McUIrPmJLOlyiFQJl6VRJGVtDRJjAyrfSLSkwGkvZUcaVS/8tXWPjR4Q9NAoZ0aH+lTc8xCHgEtBa3Q0RfrBmaVxsEYm3hQGGYGLn7YWic4qmFIHiiQXXxYHyEs2p8K3YYL7wGu5YclTuv86n23ML7V6QekydB1CJj9mfdHOX9Fe5ooGZ2pTJZUoh0xCcgeHwwRv8krpk0octq1Vys1IPepNyU5QpV+hJCJgz0ItqiD9qA/07C+Ajb0aCPLB4NxyeVuDkCCSOimUiW4DvKeHY60zs4qx7eQ6fHx6vL4qXXOvr0ik7itR3s0pz2jkeYDilKcrAndiD2YBxmMhnPHEn0iRVsXD7Ikh+4GToLY5qxngJPiTDKlnhpWQb+b+QoJ/k1MX332BI6/i8Aj5Gpk4eNZcUEK4RZtQUxAdQ6OPlb0k+A0mBBJELKEewjlnpauQ6f8sCmNMLzeKwtCnHosP7ALtWxH8mZtRKfUbOyzzcAglxkhITubzSlTroKJWFUocR4UbstVQiXBb8FIkOPZjxekqUapCk4UiirGAy10q6qIGxBAQk2+lmxeCffCxLaAzhNLsRomJ15cM5+FG64dpUBa8CUHKVjnA8+12Y87KXriz5GgnP7t2wezDYf94fHPUPnl0Njk8GRyfPDrPjoxd/3V3bI3Qr6z33yBcr26RhU2IkF+3REmPoC7MeUP11M8gRV409B2aLJuqGJuUr97qWetrzvhgwJZ720sHTTgxef1rSEQWis9nHuZ4nbf9hs6RoO1h9CCXN5yjrscURhM2C8w/Bg07VGhuo3+RwznVRlw254cce9UAK7WQK7ZJ7/lIw3TzAK8hHXL3nNi57vVYC+AktvDugSFXV7jq8oLjSlKuZvKNrl77E7WtZlnLjez4PAuXw/kbmOiNUovl+S0n+CQptbsPFhe43xhtx/t8CcthNuLrENYHbZt+5bjkWhBT8jFC8iQLrLjs7LQpVrJN/o8pw1/HUoL52Mq0eSp5HtWmeB3UuAe5712AsWI/RtC2yNfRbdXZfV+X5E5TJPamEmUExN1zM6uBJUsr3FNYdrmD0pyV06YcLhEqRhgjhv0LM4T5mSIIEiUC+btBmuzbR/sGzw6PnL45fDrr+Nvzu9GyNLNt0lF6cgXAJ5mKzyp1zOeaHk6PBoFjHWE1FdxeXh+tJV/GcQr6LEh0y0m5DnjG0Y1HO8JJSqqHlS0eXnLDHSOEZNYdgakus8HdQYcplLMXMSErHAfB6olXoLQ0vHQCSwF3aEAUm4HUIQKiBSkods3yRLkV84UJhF0Ys6lbeyQGpedbWeHU6NP3m4DyRakopJ2G+MVMvnxmtdKmnrT5+0MlP34SUEWlPWrRi/7I6ueZJWPrRvfWIo2x/sJ/oER9xJgceA9fPPfnr92HDh2TBTzLiYbYjChoDoH6AsuqnxcqwoM6kP6eoBC3ES3af0aXr6NNM4qvhKsEY845c2O0doEz4YHnhCpKZMa2lnTFeQpNkUrBwn5D/jbxu6aYNvqE2tBWd2s+RzfSC7AcgFbqUaRDP6BHsGO5WV0UJu/hqJpYY5VxAUFu5eAiDrgUthtBx2zz0qg9sNmd02cxauuaSspkoK5/RB3f0F3AqQvZK1LGgPhaoDDFaKOQ0YgoX1sVilAhUG7iuonsbIRXXtsSKzrclxduPmpRygePGz3FVs6WECDKD4A2Sb3UFteSWer0pCHrACnrQ3jIq6ynax+veI1pnCM/iDlHBoPP6+xDVVjia7dNe2E8eciyPoq0QQcYs7sB6/v0Ni4EDrK1GODe2sR7v4ICAwE1wmAD7KydN3K0/0na5Q1vZ4CQAywNjbxBYAOe8zq+bghfY3KAVFVgk5lswg6bkuweIotkkYMVQ7tcYMl2dkeI2+ApG137NoNZsAnsbWy36LtPg3DGyIBbjidgP6V0B3V68lp3VNoSfF7IsoBTL7z5g/u5lvBQV23/JBscnB89P9gc+GnF6/v3J4P//dv/g8H9eirw20i39v5jvkzDnik+F8c/2M3p1f0B/ScZh4LiaQ3YjyBAo314y6zRc4hM+8v9vTf6v+wPIbcj2WWHdvx5k+9lBdmAr96/7B88O7hsS1bUDO3Mb7PfFzshSLz75iKT5jkIuaiEUFjWkAhg3Q+oH52FBGASKIsgJlyXEp6IfqxImlCzEYxCv9YTwiKPOCKJoBknwe6MdlQuhZhk7AyT397MkRlO0vMiIsfXVnREiPsQjJzS8S06u5gheIUyP8Twn56k/2mXjikommKA+hBNNRfxpRdDFhOI21/NK18HUZU/i3HDkUGKKsrWR13FupHXSHJ/2kp0cBHOrOWJ0buAUEXoEOga+IX3anyMgNsAnnyzwvZY1iaSzsLBpL4Dva4MHekMWkJBNvpX3WGJJP1fpLai0DhviKsncW93GAHhDgslK5N32mlHdLKw4CLkTUIxGDXzgb7UMbwMc75kCD5hHjBVaQKESKDM3zepYoewGkUmkXRM91ELCrMuer2LM717GDMuu/ef9/LjbvJYRMswvl5YcfuthAUg4aFzeEGVIvVLJpX668TKGIzC4dBoNQjR9VzdUTUbESWu5XNo5KJ+QkF88Rbc+jARhLd86PABebSkeIT7xDdh6TaeuPk2xH46y/rAGq1FNn3avr4ewtrxGcKvVthb3HY7GFrNlEo+KyR3rQo0W5u4QOEBDekK5BqSzcxMC6trEDUHyJDoZiLMC3J8wdY/2nP961JZBBDLKG4ql0SeejqMGtYgxoBc7wGqVpFq0hAfjbCHGIKQ+hFoPtYJPAhJ2eyGUpGMKvMnCJpZLkDKr6EWxu7LugKRn1tG41PmNKCCaI0YbmOkKq9CA6QHLWolQPb2qz9/TAWDEqr/0qzMiDch+fPeKlVLdENMlnUnWDfKGZ1c5MkBBowacVtzJPE1mCcYHCZdhYjr3ogLVTGa8DCsHJvAJ2oijHirpnO4QAM3aH98xvIs07l6x0CfMC5a0emwPx9n7djBA5+WDl07am2u7oofepZ1OSs3dpoV5J+0NQ2ggJbHLEihmerImVC3JPWZ1WcPHNimKDem8aKL6Ke/aJoDpdQ7Y8dkd87kGf9z6pDYy5J0T230Dzh2oQCmY+fgke5CRwZnNOca+I9QB8Nf+YLDKf5DpwyXdK0E38EDtAfBIOzxGJ46XSNgNwCYIxas4fJQUQCzIwWkF5NWpZhqeipR5DjoT3YOR7a4R1YJ8etj2/qR7YXcvaSC6279F0xbNwKptvwo5JMQZIbSIwbUmS4ROI4hua69XtRxxH3jumDYFZdREp1WSHZHmRgTcoi+Wav0gqbSLgrfCtOMwd22yT6Pe1SymEcYBWyRsH9B3Ra9/in1RojETIZJVA67zoGI1Jk8IlIUElNRHECSezSiyWldBUUgSyuLqWLAPaFRJLg50KljwB0aoxMFBmw7nu+XzTv1DBF0zzmcsgNyQGclGpZ5mFn/Pwu8ZZM6MsiDQw+PmOE/DCNGeRYEX3l1XjFKyk0QMt0c3W/ji7PJpFoqNW19E84BYHYoUGMQ0w4g9cO+hWdrUJEW4ua5ApxF3TDfJ7wo/bAgjvFjndYiLrjP6ZwQ/fRz3o+FPSm5MA6AJ3Ca3rUneuSMCCvv6V63EAybyWSrN1UcM7tZUYfM0gge4IcIFozHk+IY5tIMTJWQqBX2RlISwKSLQ9Dj2mzUwku+ZupA23VfDHBzF4H1sBg1VptiLh4Oo0ApCW+zijAbfOa8huW9vOIdK+4LPd5JmG3w8NuLW2+vh9curHWzFyBX7059O5vNG8MAtdPRWf3B0MhjspLrv3bUJnSL49+GFczNpPjHZFObaSjTlLG8PD3cT933W6Q5oGA4Co0JRBmfrDIqGBwEPCIyIZf2Z0GNCAQ/YJDWV5HIB0gmU6wjSTwor2CsDywzHXHBahYJfuhd+gz/8qyaNkt9sWQnbwUm1KbclGVZNHIXjYAPxoA3C/Y/KSQX1VeoWKu+nYcYR7H2tH4X7OyiaviZOqn4hKjdbg448EfLTI1QKzqu0oogqhhUaz6wqeS422lEb7KcI//PtqPmyw5LCYfaODl7sF6IY9ydH40H/8GD/uH/8YjLoH/L88PjFgD87noj7WFmBUyDPvl0h9H3zZGp0XZ1sKBAawjYSq9Uk2KNqLaKMhTrQwUmoldRYKniBa88xYziUeABsIkLgB0AsNu0k1S7xmqIQwFhMWLFQQxP+zVWxp006Zdr+KJp71EApuujHSz/kRYh8sddNPPLn7y9e/43eBe0muDDhwJa5sE8z/zEVT5Gjs6mUjrVSHJtaQHhLlmvzIaCNEhG9up9UdQIBJVE8QDLcmXDzilMeSuzvjKpLGKYzsBE84M3yWp+0CgnBN7BlKXS+IXGNO2fkuHbCPmAWX6bRH6CcjJ9MbxgfIpYk6m+5WYKoiHcGsz8JI0BXAUNX9cWHGa8tRhSwpYqeUFJEhIsUA2kSPWChaom2M5yt8lZANHMOB6mF9p7x5mY47/BqujS4Kj6IvHaix2ayKIQCm5EX/k9oZNAjydpjCyPdBm/+7s874X3oX+G/SFtXfNqFUo/XTD5eM/l4zeTjNZOP10w+XjP5eM3k4zWTj9dMftI1k2376rO1fLRiECacYqiW31ext8DpyCXt73fXcW6ls395u6RR28mC4pjj5yu/uy0V/1u8OwLmFRba6/d1BViw0RyGGpGTByIFEA0Y5fg0wg3Fib4WFWxl18QB4NUe+JbyCC74jwLeYZcDGh30a/VB+NpnwxkORoleNsmkaCHZxdC24OuoB3NlW9iH8ZqGStGRUWrwHBbpNQbRWQ1efSgkSeAyav1OjsnEWdhpUuzN9Fzs8TJdqUgFAH3twX1JQmyiwu4ZDBha199BibZLEw+IcBZH2FqFnKjOrHyiJKTiV5UwEGPzB1ErGABSQpet8GRCu9OHSkEkWXfztq/CVl5OxlF7cOVSWRfhqC4Fx78X2nVKmxhAwQWAKbQb90bAUGxKTkHHTTb9FVK+VLlcb6WqVUp6OusK9mRn+utOD8NzOx7CztNumldqukbW6dY00LdGzsGDgo1CMCjzx4uzpx8VMbv7g8H+7jreiX9su5inZsNGrLuFwm9+YfTv6Ebo39mVz7+zO53/OS9tlmp7rUsuYKwm3hjkMuzIELpsVNfOPbp7cPT82fGzddkyl3NxvcW+cq8vXp8jmKijpEa29zilEgdUXuuM4HN4Ol62neeMKmBC7AkuVJBc8Uyb6Z7P4YKkZrs3F4XkfRi39ffsA1zo+fPF8E3TqBr+09D9GeLe+NbfeqRkhKbLme8x2tFdATRf73YcUwP0FlzfDCRWHiZkCH0pHsqG8+1x4WtdtA4DYD2dg9EZOZNiyl0MOHh+OOhgvy9oO3WYTtHmAXVEF2gMZ2tIbPF2lbSkkGiYalqJChaqVeFxjIp0kpb+knWpPHrR9vJ9zbmhEokD7qIPzQAKD9AYvu7d77/LJqF4Bz3MO7XUeytMEbXqDtOuNXY08z7ZtNu7i5cer6t/vK7+8br6x+vqH6+rf7yu/vG6+sfr6u+8rj4ljpW/igeQpZUduzJt76kEgCAm0BxMdtQPqYfXKz8jYEa8ugTvltiBf264bWn/+bPjwzXkvbpx/d9QOb3CmTOYOWpbdjnHVNbsmzXktuc/wPUHMOwJLCVmjPVYg93TrGtpk6zJBut6a+5gSCkCgwg9wT+iJ9g0ZXpJksKTyxU3MVSeCNM5pw3O4g9Hg5cZhxRxxSHgjYLYbmmirygXkLJcWIIHZRc+uRy+eZp5OxjGg2scfaphEl9OwDPsIKyhSgbDLmkUGr7Hhis+Xblp2rpyBxZcfrRKBcaeALjQNgWa5sC/xZzLsvm2m/B/yETJrZN5luvdb+65uVrrI62thYHzfa7VNo/KsECU4A0jsyenb5DnACmwOVMSR+J3UoK6oqOXmf1JTmdsaG1tOGSnX2Jnf3Y6/DwC1cqZ5daJg6OyJ6dPUSG0XXP/8fJzJpY0CxPFNhngLB0YEWNPzj51/U//9cfLHvvhXwMfXKi8x3748V9X7rXtsdM3/3oHrySg2ZfhG4gAl9Jtm3HCsEHWvXraRbXXusZqO/YXKRafM0ttplxRAc6WZ5oObdmTHz5TgFyo/EsRgpfXtZLuN6QHLxlgAGT58RPpsuli6E+gDSToiWttrtGT8LBK8y9BGRwfFLQwflQErnrsElW3t53b5JSXcqKNkvyTpq+0u0ZT/wHzvSu6cLV2S0y6nNLCXcFguaATwbdnEwUkkcgi65reweBg0B+86O8/Z4NnJ/tHJ89e/o/B4GQw+OTZjsVEm4cs72dN13dyvedU91/2B8c41f2Tw8HJwdFnTBXrsvPrG7G85uUUDqfZfEs8PQzjRTdTaHGUXjl8I7o39bvL4edOOK/NrdjSZMFowfH8ZMPFO2UJ1Mjpp2bKLC6GzyZMwGIdf/w5xj87iaSkddXRwf7nUkp8qLQSyj2AWHf5Hc4JXFx46GVxu7bsMYX8nrN9fnT07MXHWh1+IgW+oNcFWAHABcs0WXVb8RyKWNlYum5z6WBwePxJc7HCSF5e+34lD5jJF2gQ7odu+p/YutkB3ac4dqyKLTTyZVO0Bv9JKtUNt0AxXlYzTg1FekzGsN445ECEIkoIAWNqIaTUFk0SYgt8PuN4JYnpXoGjo++/++7l6Yuz8+++H7w8Hrw82z84PR0OP21VQoL51qXvRft6y3Qdmqz3iFTGfhLN/RI+TySBzEgNmWBjR6nYHzV7xdWUnULRoWalHBtulv4utuCbn0o3q8dgtu9NNdzWszfV4KAf7031frZ/uGdNvucrnfaAWPhHNtXfvnr27EX/1bOjZ53rA+bz0fP+p54P5Gz5fXgTbHQnBLS6Zmxn3Igim5Z6zMuo6SrhPpMAvwdvQdd8f7z8rIn9Hr0Fq6KQcKWGsZ2r7t0Fl1f/2qj2PfbqXy+5Yt+D00jaXCfuhB67UHmGzoOvwy+/ay9BiyqfNc3UNt3yVDvdBAGvrlm3lv6Lzfp36BPoIMLD5/jf1banTIntqoZ/adIzgNFIT+vk4mf3mVWY0VTodg+TPwp9nzuO/yh0aNCRY7s4Y5aQH8mpWptH0wMlDkwivWIyVhm2+9agATMVOn6SloFTjoW3fainvRP5DJXmpuMwYHbxNmjAcFWfD3/1bQ25saL4hP4fuXTLbRVNnwbh3Lm4r+FeDMHLdRQ1pGYL5a5X1ICvhefVQvepCCpfSxCP2OzazXN5M7wPp3ZPckuLkWbMxsG7J6ONm7Eh2lS8A3FU066l1dtan1PSDC8uf8A4UifWp8ONqG6L3QnNjVxyyhXvKH4MIuKeKE6Fvq70alpegscrrabSQT0dWLold/iPTox2/5PtlFrtnLD+i2fZ8/3D42eDHtspuds5YYdH2dHg6OX+MfvH7hrS3TT9KgfG7o9wU3PoL5X8BOzMo1DuhXJaZEP4bWq4gl7dqWrqZmIJMl546Z4kvZwGx8FKM3Zp6CYebP0JrVkhp6DUcOMOnpu96JZYb83t0StZNVtabCzo1foey6PimqDwRrukjTy6yODin9rpOR43yXnSnXoz1tZp1S/ytfWqtHW83NaO3X2Lw+FuXW1nhfSL02gm/xe6JabJsl5pl9b0Hh+H+xGh0xRODQfShv314m3beKTkBOrEtJCFKJf+wCXpAGc4/bWbpi8PB4cP9rAbMQXFaotC8h2O+DEZ2f/z6SZctyQlCc+NQvLPtRiLdf4NLTu3gOEVtUxmv1JvzJRhe1E7g2Kn5L2NE6KDdG9o4PYqqfjed7VQ2l4PpRH2PowVaJDqd0HHTR7dpeXCnPD3oOqCBOxoCYzvhEYfRsQkQILJQn0FKbsP1T4LPU8vzvrK50aq8sTSHxQ/iCtGU9hcgAxnepLI4fatCYq9Ohu+hQDrEG+8SboH+Pms3oIbZiuL7XrbkwS4UNXhJwo9N2ahMfte7EW3hvAWD/V0bRDJrMXqSVY6cfqfmid3mnPA6fB1YPSGt5MO4NJBc2CfTx493WkncH/ur6SaYy9cMs2BwOSuBSgi3P/8+uyoB7k5+09x/1RGkOKSsWFRBKQmsVWk72RKIMZLvNMKuiOEoqU2ijg4oEn1mv7mQJCAzIqKG+60CSKFt8/SJ1ZBV1OIefQYompn/Nn10f7B0zjBphVBc+qmF1WvTxoFRdJjqIZTHu5SCkgwAx1UYKUxxCtzSjZm56gI9aNtTQCDdP07f0ZFCvAD0JIgYlvWeMdKQBFbtMQYOyYvg0vliYM2m6pglYD2huH2pnLZdDu4v/D6PRTh/z7q738/pfe/n6r733XBfUASOuOuiNXmyZ1idehvOFxt9Es3j9HeB6ElFdxkmtxoAFf7wbfZH2hnJTHapjUWWgbrjXHhQ+gFHLc2AV291gk6+oJSH99jc8FtbfDChvRCpD8BQNihdqX0c8ZNAaU/PXYrjat5yeY8n0kFLcPP4L4iE7KihaF+6P+nHsOVV9jyFdJbP0Gm3F0I+sVV2x9Wrv9qVYR2aq8fjp9fPz9cwzuv6qy2fCoewPB41UxxffclNm+Fgcu5sVwVDeB4D3ZyLwxlEFBagZ7g27lO1Wk4I6Xzpw9duTGAM2I/8iVjlxC2UlM24aAZgkgeDLq1wBP8B2duAcai8XxJNxCu9jcC5uNwm+A0KDgRYvjc9uIlJAPkpP3IOvA/IBR0jE3xus8uTxeokPYmgwa92Wqvh89NYHHaNSkdoZcEezLl9VQ8xR7C4RJFf23pEz6dwp007XZ0zK8JL0u4E/bGPqXWZ7FzEd1bmOuyFPlqO4T7k8B3MN4uDWBMJ9TvhQy/nc2FIwN9GrEcTpFgeHXvt17STszvsjzdXgAEemhvsMciRG3YG+G+u/jhMuACm8NfqflKqvpDB2x6UU/SkSJEtASpINC0Nm2UFKc/vLn64fKHhy7TVOjsnyA4g2jG2MV/4QBNe6JbWpR098TBPyFI45H/pwnUpOhui/0J1YcGawDV4Au8J5q/j4ANIN5N28egze81aANr9hi42WrgBkj+zxS8SfD9fQdwANH/zkGclBagv25ptXb/RGMFGsDYyea9cHThalP1bdkiXK05CpiOwMMzhz1phKuNsiGmAC8ET0m2uzZTWWxjjhT/QFxk2sl7aCOtweiAfrfQ4GVpIYvwl1r0QF6Tm78JekFcTKopXL0hFdxSaZhQt9JoNW93eKcsy1jjA7f8MXSLALVHY8FdhtTrokx1T8rIatO8YWmZrLrK7MMoc57fc5jPZjL2eniaohJfBuIo7fDYoGw/L8jffX/KXgwOD2BpbD2dCmiKfsLOeT5jOnfCsSfUX7zHjvvjJPEU7O2nTCbRHfIKLTT7OdZ2/I3NxAdeiFzOObgZplBMOpW3IbaC6x5h0v7wA8P5BNeOWzmFpBMJt38Jk7FLb9JDFBBf9IFUir3QlTgR4mxZzcSGQ3/3553BoD8Y9I/O8c9n/YNncI/S6sPDnb+t8862ZMebO+VGzlUQGV5iJNIikRI/KvmB3I1BD0O/0C81JK/KRO9MbHT06HL8a0i/bPx+kAMJxXFAeAiAMVjiQlhyM7SX1WnY4x0bkC6Gy8QUOPaLu4g2OYfAbGXg3sZ7Tst4Px2wmJnwvIUEC1MGyn5519AKCSqe3wj3dYhAsH/XZJBqO6xgRC4w8TcQ43dIg23yQqTH74AO2mYTPpfl8gEz/xwZ+8Ml8+OxJ0EnNaLAi24LMZZc9djECDG20CLPO0S7WzP5tzvnU5fl15vN77Ll1lqcCzBZ7Qcb+0SSV3Gj8v+a5+yHS/Za/53fii4K30AYsNwWx6zOzY8epwOqCzN8QVfxd87oMDvMBv39/YM+ZWV0zapby/jvyDdpD2ki812M8u/frE5F2yxkXX09it49kzA+yRlwjGnbY/W4Vq7+mGzhZiFV16y22PEe0sBR8I9o3HCxt9PNJdr+LNCrk5fKhVogFm48hxfHRvMCTVthcslLL4tly3z5Ib5uoai8LPUCIJPR2MR9MVr9JORviacnrIQQUw+sY6S0kh+aanaid6pR4xjAP0td7+4awQqBcWB/vSOZr5T3BJfFkjKd3t0GBu64WZCYXpGxt6XgFnLjHastZk+DaqkroWAErrC5jvBDnZ9e9iBWDonYcBmyTPQAThdbdls1ONVv7rn3EjaiTbUlTlrbGzT8vUTn/iDbP8z212bRvRO+vE12Bc369GTVHoOQ5mmp6yK0KzUhcOprzYBVyE2DWLBS3gg2cgcZXONQz0cZu5iw23nDoeuhUTL24K6rSStWG7r+pjVujRMlQuxypqzbZXX1wLsM7lI8L0WuVWEbhTHeQF5X3cv77OBoHSUwOH/DOHqMbwfr96unLsMgGTZx3NKEIVzU7hqZdSMF2RQbgoT/pVUTINCuRd8JaSVywvgtlyUfb7hQaliOhXHsHFLyRMf5jfTErL/sv1difjLxf7oc/QT3r7sLWthvSNdfQ6zVTf7rohS8/jAupqODypJrQ6nf6VkEpxUnwakYV1ot55BIGEHDqwmb/ejv3ZYTNoKPMlmMgMv8P0I4BM9CcIBO/JquXvUNTXa5UrqRB6QkbmLIrcRK1tmQVhXn1o1YN599CdR+l5L2cqZN6AOPF69L1U2kRNRyFLXfrGKP7xtdCrtOv6+yKYbG8CVgCyMzHDlkWIBWFebRKt9qTWT3550bOeaKX/NiLhWEJIyAiyIgIRWApvGIO+gaKAAtn1Yyzq+u3t4j4/z7UL4TOy386erqrb99GhrjsGgO1qYMpiAUq8Dd5C7lTXipNmWYuxHQZOETitfCh2NdLD/XRwwCh7uTtYvYWiS4TNvzr6DPEIuuFTw+fnE36nSZ1wOQ/6+wq68o8OTZ6KNU/JMoS80W2pTFZmpugQeuMNva3sUJT2ASeN7MBAfDr9uJs3/47MXGqWxNDdodsnrtCILzWUDUt7U2reO81FMbkuYj7LyUcCshzt1iv2uo6oArY5QFQaHVahxaFs2N+HiAY3CXcaa06kPhSsFN4VnEE7NJFRn9e/+dx6x/cTaKUEEv+Pf+KSEqtYJfs93OFTh4Jg6Pnr/oi+OX4/7+QfGszw+PnvcPD54/3z/cf3H4CXn+YQHnws301haxtU5+6ITQb40E1VZj6c9+9jwb0KWUwXc2rWUBNUJYmEP+iuKkAbBzFR1b6GZj89qCY4tSVUB1A/DR14aq9C+1MEtweO80gIa4WSIa3mMWR8fkv8oIaCQNSYQ5r+lMCVfwYLkk7bcI1M838FFNuiLqlXNeLlkhHAWfGPuhBShcAA+JNK1CAKmQVgfZIBt0ss4fz6967O0Pl/Dnj/CHvrzazAtbvot097WkWyiCaEKJ1BZTrY0Yy2ZwYfHKiVY12JiDM5VMonD5ZBseHoCNtwo8n/T+6NR/0L9CJ7Hfxxk7havhTAj+zFOUeQQK/s8IMxkNqg1SsCQdgndtJsqKuIBWH4eBy6Ysi7W+jM2hNZ+ayCnewk3iq1tYyDmfir2pfPANUoRxZsREGLO1tl7vaLgm9zMVEp0nUmgHOi71NDYjhKagHXOylVZW/Oa6l0fjocpXivyj9gXa18foeLf6Fej5W+tfNIvPU8BoMr83oU1ofTmpnSz5FxTbBLVDbvtfPkVwt6R0hEoK5heX1kRo6AFZ2w0J5w9laR+/2rT07T3oB96cd344OFzDervxOsSXhuzeWRSPC+jFxKG25+Fi5fFd7gcQWBEMuQQw1zM0roeW2AaMB8wZQxPEp9Gtjc5afkG8Tc+7VChfVDE99sxFPUQm0ogFL8seM7rG+4dLCFOPeQlqq2k6gVIwGI+UD3GrRWgzrgoMIPOYUpVrpaJyekGfex03QuWQaDgtE0ANITyCAZoVysKVn3DRqK24YjArSGcrly1MQlZZJ0GaCHqUJA/3zfBScrsldoxsBPdvQ8jYttaz8eL3OuqCwtomwBnc2FpipwJq8AsqFBJa4q0gPcgXpb8YVsx/RackFCY1C6P4fFPEmj5+qGSSxdbpeXG2SszW5mioefnm9dtm8glgxi7ONpy+DzattxheaUgAg27mqM6ZCTe719zCvEo9bcvFV3p6D4m4e7bWnQPdyHDClno6BUEzF/mMK2nn5DnHh85wZWE20QQE4Qo6f+wIAoK1Wd2PdgVZG47gBtmcg+ElQCbvadOMn7i+25FEu7SlnsaBxiI5VrHFEhsBuv617A+j1kTCV7GTkdOU5gAjMatrk4uVGYLaA5MQRQr/D6OgGMFljIZTTgUbIZ2zP2AQCoIR+AM4DDz5st0Hy0u4yDP7unextpln7YZ+IDhg4W1FnCHLgfmhNGQlVTNCvesK/3td27+abeXHXXCrdnedb14B42MJ6JTu/y00Zl4FrkwKG7t1wb1bbvbgtulJrfCiYJs1W+7e0qd9efYXjbq1Vya6qGBFYhluWCKKFK3SjbiaXsSXQuKRZTwFZdDchH46VtwKuKkNvHDJvUbSZ+dAVh5W+0y1QC8ubgnEx+cm4Z6icQst/Ir5TbeEnL7GqFjqGr1zVe3SnRjlAEisgAybCRPUmkvc3/Gnpi8aY5d6LsIK+5qf0YIbNeqxkTAG/k/iH41ew8sNXmBhjDbryw3SwGxhva/ahdI0MGkakFPBobc4FfnGe5hqW6PgSjdjuPwlQMpLbkMNjlQSIuLeUxtHQf2FLC/O8to6Pd9cc6nNNFwQ669rz8ZaO+sMr7Lvwt/WCOldtxls3ayUSjxAwFGV112UA4hJJUO8XpiiCcFMJTYFm4oIQh7l1e4vK9usgwqHB3dOcYtKyu4q63zyzFdmHZ939R8Mia+xWWnOK+iWFUkHWPhqMEz+yJ3/rhms+xOAiyImHoUb9mtktezv/JZvXIxa5d09Ib7KWqwtBQ0PG4ziE6vUX6V6x1RlerlHe4J8K2dREDetGAys2FxYLKsFM5s4zsZawvSNCNrf4shsVUqHefDSMUgtU95BCtfsVty4NEB4oZCbDRTXkZYyIrAhwcATNa185AqsTLw2rECIqSkdSE5QqGOYB9WaRphsb21CGZVaRpgzfgvXc4GusmQWzqW5LkDxJOMR5bcofIKvULkuYPbaMCUWkJIuwLiY69t0P2qWl4IrINAKygl5rtb2JF6zB60AVcEKnV9TTjwcj4W0kJdYMKvhWrac43E9FhimS4s+x6TT47fBLWegjkvEuzFG116sbNihl6Ji+y/Z4Pjk4PnJ/gB7VJSY7ft6mVg7Gy8GDJzu9ft1Pt+4gzVeH7OJm2GPkkoxF45jE3narij4qAVJUJ8gFooqyhy6h6WDMXYrOYGKmfxWCPbu+1PLjg4PDmHbP9t/fphtmFM24bksIW1mG/7F3WTmdDcfCwgEgRUF0WoeawQ6zMHBBrzrdDJTkAAw1Q3d96CSwR/jTYu9CBK+PXjWzUQHzz5Kuy2etQkFQX3ue9f6vYnYMT/cFC82zbGCkH3acudrscYKW4Rxw4w+myVEA1Jadsz+0BDtf0TNviEQyjTS57whYvy5IT5Ar2ByJwRRT9wWGQtH3n+5381R+8+ONpE7IvJ52/Gjuy6M81GGWbXvWr4LvKbUzoDujSBKzb2ms/bqwBGup9yqf/vi7PJpL7XswDRbQ55291TDYpAzJPw4yu5EHQxFtN6DoQjIwiWAuYvwEQE8eTSSkpdJ9W+uK++Ao1mHjzpR2b37EqtNTLBtHZ6m8psxSRyw3anhXswB0nATZyQOg9+QKRIsOvnhPNr/gR8onNJ2yr5pPbzTMQtrGkIy7QbNMPVcz+e1IvXQu+CgQTupsjztCY1KooeTNlhudORkpE9q5xyghyxeArvaaAx06tu0OuwBgaDGe7GtLTXEZWRTeSsUcEDbh0J+sMpop3NdgqXaVOVxM5bOcJP2G+CWms5QIo2aWq/Lz2VuNMRCZA5NqUFxxqZqoPBjN7T0ZXuzrBKXmMx/6cFJKMZa3/SYW4COaQiZRVi/EKSy0tVkTSzgRKTCZ1UkjittGOESJlMIOM2KmDCJqnvjE9grIOH24i3TC4Ut+cAlb3tpetRCmnBvcyJtPisZEO8dBiQKndcxxBZhWx8MZTsXIQQHp9z56eVO95nO5XyN5TakoXRax5+SgrLrc4BxGB/MwEwrDCeONewzLHtayXa9mLCRJ7zPkxmhsjKCRQB/AITSw3NDXfl6bBQ2N/3kVSLZrJCt592Eefb8eI0wJHnc8nprscTdoS8F0pMYeAHbtJk0u3hLN0J47uOWLURZkrCMYMN2jVuCt+Uo7Rwsw3Ral30+VRo8lSwmETsdUpybvT0p2zXfrwQ3is1B4eSu63psYKJSTmduLxKzLwu8NaN7HfZPZj/8D/vm8E//4/Ufj17/x97x7ML8+9tf8sO//vnXwb+uLVFknfX1+eLenZ2zMFjQNMJx4AyfTGSevVfvwkXjgnY7OttP3iv2PoJ9z/4Q0i7eK8b+wETyd6nGcBO5/4euXfIvcO8axUv66EP4VwqZ/YHVCjfDe/Ve/QTxozmvKhAKeCKSVPOnJlllc62k0yb0IhYfXC8F2RE3akQjgNm1DNvDAlVupVj06HKX6BWx7P1OmPBOClob9n6HZr+T3YlvIDVcMiuMnAsnzBr+KewwlbvxbyG+uqxxoBY9Oifnl2mnx97vxEXDf8VF26HZhmVLCJG9V43nuPUJ+ang3MRRI0YMB+RGCrrLQVq4MUK5FFOniYHHq1pUsAChoT8soUW9hdJx4iAZ9IYFX53VLbAezWYmcfDWiLQpOsYKjQpToAFacFwmSFw1hfxJ2X6Suw5PLy7fQqZyCvIvb9/EI570eGOznS6pQwu4Jl4m2iy4KURxLat7ShhZbZIf2O7w4m2oD/fR3STmkPxELuTK6A/d+aX7Lw+y/Ww/Ww+uSKh92+qFyNiD9G04cN7g0OxJOAwWi0UGOGXaTPe8bghqit0LR1TfI7v+IPswc/OylQ3D2CUdT6g2QRcR2LThS0vMwks5VXQwAmNDF/3vS73Ag9Pi36gurwUba3S8WREKKbrm1rkgz9cXQilhvpgzlsypDKGmaSe8AJ1VqtjZBHYQSbDstuSKXk4As/Y+xUxBJcwc+PMvr4ZvPGf+0peq/4t/4LhPWJGWUb/KjA2hGmaFgoRXyGiA4TPp/ev4d0p9wDkkuK1kl9R2BSziA70SKR0HDluUWE0s5HhwkO3/woTKeWVB3oM6CfNsjg6f7dcC7E32vwpx02M/QSfcGTc32dOH5jrgomQ02wcs+efsOlyX9YSzVtpiF6PuDz5jdlv09PxAbgvPgJvSyu6c6icmDm5xkm8aw9t3M/I34AHfkmUXsvVldG10TvWPUKTCfpITuTadjZ0Q72PgdRlyof3hp5hy9G2HMdf80mHOhR8jyGDYbTboDg7XKUFy/J6k+JyF3X31InjA4rCU+CU+ZAwOxx4r8Zz7O89vek1yT3z9d+pBSAriG8pG7LdB2kuSBYE5Eo3He5WwXwwPd0yCmPg/frx242HS9hvKl3wJabp1UfWYy6sek9Xt877M51WPCZdnT3+fK+LyjgXpLMT58mtBKfg/XF6w17oQJXMthxxMMmyDV0DdDGh66CmbePcqK/Ieq+QcCf37JDMgvkbn/6pn/n/t0z7MMsBrRy5+aD+9M3QxTPL726EL7vt+89gOuAeitoYwC/iMOxz9hUBTNSSB+3quXoCPH1Fi+Ech9tvmDblS4Mz1Tbeb0zkxrtOkxnCbpEcTynZwBEZTRQs+tqJbKzaDK7lrdX8CMKsnDobLwpUEq7dbhqia7bGFGMMZ+QFdH1I5U2PbWCp/02qvMjhfeBjbthMKia+IAHujgMCmKCUjYuZLqa1lXaCBqsO3r4k0se3bVcqvSawJbgzcHGrSk1b9DaSWqGUQlEh1P08b+cKGMgHPG5bxe9AbZ0FQfcadkXnGXvucKdAd4EIJmNn51SvwHVUa7kuja5SlggXAewQaP10EEzRNsOkgSJlrTM0FLTHQA1YXVIxPiI+JdiHW55rVYa9TC3k202COpuVdGLpK6pRQZwOaI0LNGQb/gdT0DIG3saRgIPET8oshXEtjkSGbMXbp69W4mbfcmS3YISrF765eC9FMrGEDL8ZqDRtLOsnC/9LWs4TQfUTo+ppkkUjZYzXbF69mW6OxLLZO4N9XedsaRbaorjQ0+Yr1bh2T/a+qbqbT+y+rdXZMuPsysa8y12CGhfvEQpgqSP27Zn7XmTETrfA0N4ID+PYZ1/MC9IIiWz12TuGe9vl59vqvPfandz32SkzhLTC6u4j+FhL68msPTriHEv/xqtrHq2ofr6p9vKr28arax6tqH6+qfbyq9vGq2serar/AVbWrN9Wu2w0BKXLFrOP0uV4sqbboxpKqpeP/8/uxpFp1KTw6sr64I0uqR0/WiidrnSTdEuu/hitLqv/avqzW/P5bOLOk+s29WVLlep5mB366NyvUXZAjiyYXD5UgQdc8Wei9agG+hyfr7PVfH0ztap3GD8xEbjKNm26w64yx5TvYW9evr2P1eB37lq9j/yr7dve0aZp05xqH4iR8EQPfVKWXlinGL1tFiaEnblIsEAHLSZMyHHSmJuoOY80xjSl2SgX/AlyxO+VK/rpqjl9MmNJpHyjAWQlRiCK93JLwKsXEMTGv3AYjev8a0hqWl39cW6DHS50fL3V+vNT58VLnx0udHy91frzU+fFS53tf6lwZXdS529IUIOWRRtyg4HWgbg8GgzW8rTCSl9stYwxOVEjkxKzVlWK/gFu3dPny9tDVrLkpIqUikhRTUjFzF61eKJJOdu0VNr0AXRT2iwihwVAu2UBaVsJmXc1hQ+GriS2dGRsFhRk7xRYW/6/C/0PFFf+iy1JgP1mfnQh/a5JjN/S/C3DXSL3SsuPrEfsvOND9mfZyOefKdYReNsqML45yZFcaMmtntpt8JqDrp9Or2e3rv9zjGktKPU5KRo3wjpJQMUvtIIVpjd3I3TlXkFoMid85dLJstJX7J//ySl5vpPBXEQrDtxdREI8FbLXYo50bs2RBAeOrTSgiq9zuj4Xj+w89f/Kytm7j3bVf/Y7kMPynol2bbVkeP757FZCGxQrLQ+z2JeayXY67atT5z10M+NNW7bjF10T9TRgP0vu9VGj2BuiKjt/AFqpKiHF0bZabeiz6Xv956GRTF82W5vtDMiTjk4nIE28zlliwJ+CEQquy74SCFhmpdEwAM2aFq6sNtg61EX8oTYKM/q2kSBifRKfvMZyK/tYknbCuX+minxf5wn7yXDv1s//H3tc+tZEkfX6fv6KCDyf7CdGIV4Mj7oMGmBlibcwYPLs7wwWUuktSPW519dPVArQX979f/LJeulpqYQRGZmzd3rNrhKjKzMrKyvd8HlwvrHq2GJ62J8miCC4Rrw8BjzrpQ8vaHoe4pki8RQVWpkY8IXdVqsZJHynFxaSZi6s/ewju91xsr8rM/ObeYj2I1nA1q2cwkRWQVaRTG7M2yHiomv+hDIpnzgcL5zLlqtX08ak8Lq+TXxhD3ewHBzW1Z+RFwTOjFPVlirY+BAON/3auZ+r4TGYxPARWmFQu7RCfxwxSWVoAdnZwP0RjOXUk0QyAP6LDLyBI4AB2ECk9dQ285+A8fDnvuwq4CR/c+B3fH7iZ7af9VtHCPPZdxS5WgYtnDVx8h1GLH1GCPVPI4juMV6yCFatgxVODFfYuLYmNZm7FIuIyjFQ48NHdqRp9Y7Was9qHX1BmtPiyLoNwUoYCDzPVxTSccHtXsJ6U1WQbikPztGkx94dVfjtwabujgKau5X/q61Jdhl/cAmNAtP0fqtWQv49FqiKQRbUuXsRDiV4R40IsiTPsmdW2buSCu/29q72dGZB7Y5kmS/bwtrr2PjaeM+QDQVUdbN92TLSs5Nf1nOQ/CWZ0+oaSCOvKkp3/1sVKnGWmWQvioIlnxkbJsL3X3+m/EfsHSbK32esc7O/3NreE6HQ6vYP9g729/b03bzY7cbKo8IiHIv6sx8t6Uw/tdjOEdNiTXYh5BH1ZZ/0aNfb2e9tbBwk/2D/YFts7nYOD+E2yz5PduHcQH+zMhhQDIJaE6VH1g0PWHXATRh9ykbnk07xQg4KPKLaX8mwwxq0qlWVFTYn9G+isjZlDG6Lfl7GsOrpYf6LrqTNDCkvyKx2rpekeJ1lCx5kN2FDdhsSgwVGeC2yl+FiLYh2yL22zQap6PG2kmfnVPARFsgByCS/FPOAvIIypCWkjzLMUTmUsMi0W2P4ptG29M9vZWbyV5z2E1gmZQD5BHeTQv4rSvl5Ee/ylRaDmrkGO7fnZ0b+Y2+4d4sk0YMEvmaNRdS8VVf9fnSd31PvXLqk3XjfLt27O46Hwi29FnSVbUo1P2dSWFeepGeiWOI74DFNQqtEW7pxlI1MGUG+MNUb7xjzdOBRpyouNgdrYjDa3ooONGXwKQfNulhY++g1pCLkNEvvNwwCf19oo4UjqSgmT1cxC/CcY+tNABceaAwXZCuZc9M2EarcAVR41asxxIPaC0YIkdd2Iz97W1vbmNzUsXSBkVgeixGxrW1nVt8aymF5KELTdwPByyOtfMaHcKlgG9KpGcW9ZkY/aLMk/D9qsV2D8QIYPBmLUZtmYPv5vXjTLnCIfLXr0y9VOHRPM7upxMNe3blBN21LH7DfBE1E81pr6p7G12ZkqSqgc7PhOxGPzz1dnx6/R+o0GRP4tzJTDs0+1bVnJi4EofUACPNl40e72dhZll3rQ6Lkxcw123La1jCag1XYTzRKGkgo1ymUqaMh7I8LvJUZPqH7JDlWRqyIMtT2YBAGEyyZD8OkTqHDGpzuKPABr7LNkU9ajbbd9Asp70XZ0sNfpRJtvdjZ3F8VdjnJMMVoS2sHMJGArR6gfhybEOCQfsI9YN3NQsfV1OFbM11gNzvV1V6vj0oj6MhuIIi8wJaYnMxq4Qh0vGe8jXltg3k8uzbwBLKvKoW2dtE4X3a9Nbaa9e0GbMcgqjscYhdW26TKmcXI8pKgwjVUqC+7dE4DVek2/OIMJYzeQVykmggYx9VI12CiH6BG6jhI3yL+Nrc7mzkZnc6MseIzMnPURT6FfrRvirGNDOPIwh6P5Ae3Ee/ud7XhHHGxtbeIfScx3D/a2OU+295KkvyjnuDnSVzi1OW02nvfuPFVinp91T04vouN/HS+Ku02dXjbCdtunIr7m34rLu+6x0xjo35XD2ASo1x5CmYAqcdUrwyk0tQ/vU2daD/UOu438zar/Ic+qtA2a04+ogOt4W1uPAgB+OSaTjYCN7TSr2nx0isZfu+1zmVwz1S9FhlFsE+3iFWYrRBJEiq7A7tQpCTyXRlyBaY1PxEY1oNI4cMOYwyL62UAviSVb3aLgEzvQh4jHiwGNLNBtEKMofSwHiPKeVum4hKGAjkmVQY5fCq+gBqLyPZ9AuJtcG0MxTFsQNDc107JEIW5wls0yr/XXGtnRPZltaD1Eme16iv+G0wr/u9mJ8J/NvaZiW9DzivoqLUDVe6dyvBPZoPTPn+Mj7EMJRpPmEfjVQ+dqZV3jfDtjDVQAvXtjzN5gPOPpREuNfsNDdeuXHPFsUp0Tu4VPwgsOzDHBuQXXi72nl8r/ARpWofN4pSRJ6yrEg9pneqxzGUs11n7qavOx7DxEqoQngUf6SstBxmF7ROJO6lLPHkpj5n5PqVTwbN6Z/Gx+DQaLeY7l0QONM79bOEmpCZlWWYxF64kY4V8yGyxxeuXFsOYydFoUAKkx5xRHSttYPBg5SV8PW5aPeDbuc7LXEqTD8cobZMpEooapEVTSn4obO5uumyMU818fzqmtUDMbxWoUYV8R3eVxRIUtTz2Gkpdj/WLCWbEocDyoTsTlLMdzjsOJhVSZ2V9xMclLhCXyoYyZwBRoXQnjcNUbnsokbCoIu7rAzBq7H3TWG8HGmQ+v43rYHgr0p9WfqP70+n5ZuLXHGcWvRNJ8mscfP374ePXp9OLjp/OL46Orjx8+XDz1OMfUi2tZPeDOzXY19QwQkSwRRRPSX8Vqn8K6FHy0ZEGCLb+mNKH1KLYIcYG3JZAhVguOKuHhF32EEDn+/bd//bn/fr/7x1PJjmsjFiH7F16m1jmSi7WdfFTdyYZ7xuIhl2G7iz9wr40iX/35vL9zDzssJprzASsXPeXKoayEYS33BkK5PhsDtRRKpW5kKN5/kU4YXXna1gqU1rO9oSSQviL5mzUDoEINZXha1xFMHBy25wBJI5UXCn9BDokJpauQHuF/2Sheee2MHiA7H0u/0YhnyVUqs7971mP95H4Zp6nDDolpti0N2SUiCQXSdGqsM1T80tZgmTJUzPXgaVppx8GZUpHujNr8RFMmtGPYegq5WTBvvix69ND7ljosfX5NQdPTIKYaIhqRZ/QdGlXUl1X5FoIZNhvDDJXTYZG0X1X12S1KY/0EJXqkKDALzdSbPSY5mOpCPn06OWqz84keqcw5Mtivn06OdJXbiCrZYBD8CJcZqKYT/6SBuYLBPKpfbRZgfagyXRbjmIQ2t74B9I2coRyKV4i9Fab7wNSKKeVkJEs5CLWus5MjVgjkM4Wz54O31k6+woxWCxBT1HZNIkGOQzfR02UszLULBfUwJLqZX+OteGd3NznoHxxsv9lNFmZQf9eej0NfZD52d8pVEt6RgCrRl2TET9O4iDtZzunc/DhnBq4pFqV32CkXFtKqkTgxayngoAgGWk3d9ppuARdDD53d7HPr+yNUmznZYSs3KRfG7uzXJWk/J4Flc/vNTw88Hkc+XPFolOwuQL2nCMz3R7skVaJmQPSQby4JkvPfuptfAGVrd295wGzt7n0BnN3NreWBs7u5dS84OhEiXxY450fHx2dT4DyQv5tt5O9a1Lbckw4IAokE/Q1RI7QpNOmuBZwprhUi0sVHMp2XqNIke3NeQOitHPRfx0H/QJYOqL5y478EN749kO/Pm9+M2Mqp/22c+nNOY+Xb/1v79uec6o/h4m9GfuXpX46nfw71Vw7/JTv8m89h5fdf0O/vybhy//+g7n/LAasowCoK8KKjAI5P/c17PkZdvpz66p7+RYm6igc8IR5gqfjNwwKPAPfbBg8eB/A3DjE8DuhvHIh4DNB/l3CFBfo7iFosOSSxGH1zEX3nVZ8Voj94/SfqPytiBLAumyDBp8uuBK3w/5FrQisqrKpDV9Whj6sOrXjoh64T9VSwruxlo263fWkVo000Gsjk8Zbow/q0nFTeE0sLKqsMsiSs993+xHoCFi8GmEePRUsmDwxLPQojpy7K5iZCO1s7W48FPH/+8zijrRztWyyfj8bmI9Egq38BPO7tLYbACvqLhSxivcqNcLe2Opt7653d9a3ti87+287u2+2daH93+8/WI7EhuZ9Ez38yF7QROzn62mxlMVjiM2BRmdvk2kC03nksQihzfz5UvqEJSgX8gX5iZ8bF9HnbeLnxaOpqIi/X/l4AuIgd8sz0Uu0hetanRmFlhUcw95dx1ivULcIaWpT0oMjSAuGco7eiZ7qOUSuOrEzNBMKp6NgipzfOgcECxzd1m2qUOxexypL6CzLkmvWEyNg4b+S+ze2tx+rnmKqO9KJEFiIuVTH5nrgQzGYRZB5B92pb4jYSdGOoRmKDp48Y2/IDODtWXg54OX5Y98YP7tdYOTRWDo1HOzR+cE/GyoVxjwvj7+K78AC/HK+EB+ml+hscgC/dk+DgfIk+ginYXrL170H9Duz6Lwqw78v0d5R7WUa9g+p7M9cXZa6vZtE7HAoxkLosJvV+kx/rnz5gYDp1XDRKtdUK/CJuuBYGdi7cjhEZqRG1gn++k67h0/pgFVRGu7LbQpZoTkmdv3pci70dJrJYId05uNyYfeMQL2YRrwYdnYvyD7S0Pb6j9icfxeB39CG0n7Xr1QPUylLn5u6oKkU3VzKz5QPXaX6Fz64jXyOicmtRoI+H1e+qNXuidKbNjSh4T6aoUeJZmCpYJdTDgfLx+Nern09Oux//bTAXiTNTWk2s9ufvP4+7h53uH7//fNHtdrv0M/7R7f7vnx7I9rXjN3pSAwM0KltPOvxDU2Nghp/g6HHRzP60fGiZnXkiYRBYZqpWG/8SWLjzc8wREctomQ2CF9J+3zMQbcle4QDO/2wz/O/xv866p0dX53++NrwSJnh6GGQwmQPD6+yAMrOl+J+xyGKhofXaDYm5sfr7T+8uTmgvWtstl6bhkLgbXkhk8LOUOnEaTLLxCNMgCdeK27Hm0T8/fDwyzH7869Xv+KkGul+3xni+Gi8RsRzxlBXClpwaYxz5qex6bXPtek46auuvtcO3l0XJLwuRXJVlftmT2eVowvMcudCPKIUGanPGbX91TjwveZbwIqmECTY2D76VPq4gRjdhD8Kf/7kohkN5swzkur1eIW4knTM29m5a7N/4fP32j3fvF0Xms5gsAZff5I3ARDOO0i2Tha76oE7zO3z+4ZeLf3Y/Hl9WlrR7Nk4vLg+NXvaHcS1enowQp/lF+gEUYPwPRER9eSszHAB4eVHKNE/u+eqkoQ5i2CusEMIRt7EsSQR6R5rohAO/fDKxgpVZE+Euj0RvPAiHrTyQgtNwPxcJTwN/De3p9JFG5loEG4eJVdym9b/wwy/0G/e9GbQooX6MhK0n7fMYygXK8HJ5o8gm4QUm5qNqR4oYqLlFIVvd20rFX/QFeqTC2m/rwNUwHKjpYjZhecrxTQxAyDCK1FZDsIsQBLu0mQQASKzMGaGThyqC1xNFRWlqtrAzW83bLW1vcVLIKpveVuln7NrSMrr2mHQhpONClL6KChQ6OcNU1ILcTc437DzTNNJsqDCsVfW0KG5E0XYlWXbRROjSFn60WZxiEl6bua/iVmWihJEQ9VVxy4tEJFcyj9hJH3Ni0Y9A2MK7kzP3dpSqgl7m1236JkAqoc4YopGU5mwg4R8/OWNlIW8k6qraqCMZcVIrw/FosqTNOHnAe5OqU0ew1dvNg62oE21Fm7vXUWth82CJcYhumoIJYLcOMcYX7KEyEKpwDGc1QqBI+g1xBnQdEYaJGBtD7WOSLklAW7uy770vM6ZlObYRBaIstmsVKIzTSOlGFV5tZQcg4+lAFbIcjsBvr8AUiFyIPjjdMBxEMERABcTr6CGCo0Z+pctlGWagP+4FdtZVLAYfBRV9zQdjh2cFS7Pa35jnSbBffj861W2WqBEKvGmnNsNV0rZ40n6Ei5BKroVemGQyX4BeMr+PGvZdODlrRHpm57EWxQJ7f427gi1p9/shvId0DvhinIqpN6r65N4H6uM4tbVwmvGiiv25snTA6Uo36blBg38neplyJOMDeL0BBvx1vLTDkkvBeCqKMuDITFHJoUGyMhjdADdsEdTZ2tXMJBxn/tC5FgHglkHfOtHugEpGUkP7wTNTFir1E9t1230VV4UuycnR+cbJ2Xn1C8zLueVpqtvsVvTckkG7muAL4yK11de6zUSWkAeCJQJpHtgfEsa8jFqwV8dHH1/badm+vleU8SMEPB+XQ7UstoW21WaqGPBM/se+rxgOocU4Udlk5G6cAQqENf/S7HaoWIzoaw0iVp2j4zrPNfRAzNwDr8q1/lo7L3mx/k4VySNMVTvefrIkwnWrefpENuMfckuizt6XmCN5uJzYJ9CRJ1iXwmMBY6n+l0jVLUsxymFLngSK4jvBPy9KtQCnJREO/tzgA8dgoIljFUen+QT4OVXxZ1bAr6NLxCpZPu6lMmZHp+emFe1vFxdn52yDXbw7h5e4VLFK9aLUkcmSiNI1+J8cGdGIFk6mcB8+ITseiGY5g1xQ2yGaA3U5WJdVYrmR8RZmts3OwknUdhDwkogXWozpnDnJ8yVSsCqzheCw6HgivjBj1k4zDqcYP5A8S41jiloGBNFBFUErmoffuXcfDv9xdXR6foULdnXx7nxRvP3E3yUh3/pYGzFcKtigLveoub1ayCd+2Rq/OAr530KgYYIxjBijI1i/t+lv12pplqh4XLUjqe9GVipufatV8WKmyor72rCb4iAsytGc/jOkHjdpUgbA1CQ5GxL0nEnm1yztBF9S3qJW0xG7XCuRRbfys8xFIjlN9sZPG086emiRolzSwYcSATTWomyzXKUynrSNDgadxyZEu4d8ggQ7khgL6zOwODkbiVFPFI13x/m2r87sU3T1i9EsF6XjePxC3ySE4EFTl10UrGotCV29V7o99Uhh5uZDnqnaqvNF1eZmp2P+b1H6LjfFFaLDZbduMAQIwkRXIkNPgCrEe3i4XXPXZrSjB+HrcDW6Q93EPA8/u9fI7NrvgvcT0ZeZiQoS4GQV4QGGg9IbX7HKMnucfW/g0CFSAJkXCDAzLci80+3g+4ZfetLE/4387qfqlkK6RVJZnAjLXRyeWUOU/FAWTYCJnwoRC3lTZcPJTJaSp+z836c0sVyUr/Rr+0u7KBasYDGxP8O7XrGc3skK5HQyQw+7Jj52dKG5sdwuTo5ga0eij+4Y7ka8SLbtYTFia369NcgjelWDZR0U2RTgGuFy/2trZdvHAq9GyWWqq6fQrmhAASQ4HK6ntgjxsJ6n89oGxv9AWNgVq4CnzHDG/z3O4mqspnHu2r9uWqwibabKmSVxR8wxmuG80y6JQ7P8hkOhHmJF5+4MSgLTYsSzUsYAELktIDTPmLgzk3utC9suKjVNDkXL1VKxG6nHPMVIf5+8AERFUfKae9O5pwu/Rx+eCbcmlH5ePU7GP20j37qUacpEpo03BzNqyLNCAabAV07en75MUy+3eJ4XKi8QiEwnj3FOGCf+kuRji24DHaE7MB9FINy84Bn15GCsxjqdGC6nv/HLUjhf+14oqdRok81OztqMO9cnhCteuDumFfgnYuzfFcWRRj5B1WYY7sL5FvzWweTuw3VkP7g2ZPTMRwlxGbQ5uyqq28aulSZY7DqS+TVk3XVkwLpus0TkIiPRqKx+wlTQeVHiaY5asyelo2wMhWSBs7ovoc324DNrIpaiPNTWQaQyNcIQTyMyzDlUHwfreqliF3vVPT99PdP5DjqB4PHQyxdlyGsyvMWcl393c++giRahiyt6XgPsRabRfQjwn5/W+qtSg1Swd+8Of5qGeU5GWmP8d05e8PQSNQB/xi8xA6A0I5SDd8aymHkamo99f2cGYHOBHgjxU6SUfaPMftEMHAOholiWkzmVH18dnEMkt8094ffwmgueNoOpslKiz+ScBn9fHdaLW7Wemuw87Fg9xy7Zh6Bp2dzgJnxOuz898EI0I7qkQwktUr954wGdqqIcsi6llvE5wI+zsphcSa2WdU6HGINRTNjJ+QcqBWuE/LB7L7jLYn8L6lyOOeQZT5opS29ao/nXCOZAqCvy+MyD5Z3KBrJEmBWKGeLk5XgO8Vr/l62lKlt7y9bfbEd7mzv72502W0t5ufaW7exGu53dg8199v9mH3oA/rwPWg2n1ictinWneAW/Antz5kjYRmUZuJ0IiN8NCp6NU16EowXKoZiwGJoc2R2BpnToFKSy7sGUBSXOsFhADbCGVz9VJh+zJ4qqZaizbaonx4CXsnw40RL/gKJRTtosdjKzshQYO1Ul6IUvGhOMLBZoNCPSfAZCOWyjVtOZ9pQuVbaexI1nlitd8nRZN7h1RtvR7WVcaxXLetKpR6UiAGVo69BmsClEPv8JDeGdV/Jzpm4zuAA4A2q0kSrYnydnrIYjrgIp5De8QKZtAp2PnnwrKaAI23820/Vgp7OzsNMd1wSZpSpbpuBERYDKviQ3138/vA/eJUlOC+tcwfn7WPREMy/DrvqPypYBpa+Bw37uSXXMW6Vhn3RPu8H35iJlH9qNboGQmsz4xs9jkSl91ZWF0IsymcwfSIH5aS5VppxDzmrBr07ObnZgG56c3ey9jmb2HvH4gZs/hfyt993DZgADyYkzQk6I8/WNuFXqP/5yyN50drbgI9NIQ8UkjLfsGGaeiktRslfW2dxm++s9WRlDsB1e48+8imgD7LeK/TXOc1HEXIv/w4bijrv8eZq3rJG+5zzL9SRWC77ZGIIrQ5YZJrJA0pdiIIqInY9jlBgh1Zm+aLxQWuS8cPMsKmViOMmHYs5r0Omsdzrru8f039vrW9szJ5jxMpL5Au/4fC5qXRQ809bNBn98zS2Eop6EnXYvvLfVNr+W1rb2yyKyXsgbBEuP3v/5Ojji+sNIz0iqeMJ6POVZTE9zkEyjClaoMV7sqNWIN3oXLID5oypPQ6JgvxdOFuPH1LNUeYjNXUP8zKz0aOt6axa2xuNaxBlw/1Gd2eMJxcs0DHgdNU0RvJpn68/lnyeJQIi3oRwMhS4DIBw9DSzIsS5knovEozHuOReBX/mXqpq1bUMFfjnrl4TGtdZXKrLfi2I1WoMgXAs/qAlfTJeBoHMZ1kgtKkYUockLEUsNjYtYE5FkXbJUfrY15SaLRo/7fXnnV6TvvEJw+O3Ghkm0Md9AbPh1xC4KmlIBFzlUxTs58uHR3gRTL3MEQvjn8LyxW8p1ycpbxVLeE6k2WiFC3+Tuo5ETwP7i3ZH27/parKLx57Wo1cSoAUVmuMWTf5lc4jcleeONpP4Y8Yv/QZigL6ujBlu7vMfq2vMq7xVf0AhCiNwYV5TliE9twkudhey1iBg7QRwu50Upg0AMm4GABJIdWoSl7O9tbqS35PAroECUBZNVkRhW57d2QAG4PTDpaBahnkAsr5H9m+8KK+fRdu329jYSXJfRaGJXMAxjbgzX5VolSE7seCb6HrLc/bQSwxmUG+u3qXTLNT3ubUV63NusXcpqrEsdvNpUCkuFYI21tpnDlyn0xpEprlIuCqnq7ensTm8ZsFtULy1VfkUofQNJKfp9BCBvMGUmt+4AS5lX4uLd0eu2afXn7cfqTPy6Rty0XRCXBAfY2fGRXQ/IRiGjGKE6vW9TMwGcIJZf+3tLU5Kk8wRpdRIPF6n0uxl+Qpa7jVAti5VCD2rVB8Cn3AcZOEz1m8UGSoXYu6PuGcRc11DiyC8V8lCrGWMx4jJdEsJwszHa0JlbsxoQAQVJPMe5+l1HtECglq4eInL8+aS5qImZu2lPFCU7lpkuhcya6UlZLy+GuQma5XA3bbVYA6CnID1/qJvNIbMpZhRN3nBVHnMuAf3JMt3g4YmZzZsBW2K5nhuTB4JAm6HhYFS+CZO3lvAKSnMrOFHJglIP+Z8AHkNq/+MnLZDdIvvsGn8UycRkKdAPoPq1U+/wv30TwZ9Oss2SBl0RmW3zGFImDzQxvy4b2lMl3JoBa+azrwHai5S050NY425kUKoGMmsmUiBqOYnan6ahJ6IWKhyt+LyXousKnLEzo51dZIq8wxaP+bmkrb/WPssez/gVT0YyW2sj/46suWxwhUUfWKvkKAAvgYynKvvOax/em3fpcvgwtnE6ic95S+h3KAMpjNu1qoNA7MwuizmxsUpTEaME2t31i6HQfmFktVHeWV+ifjpLAnGSqoG29dF+DKTbGyEem9f6iBwzkQ/FSBQ8XeKE0WO354wgkNqj9Ur2kXrFxJ3UpX4dyEfThyihnlewsG3HU+2mYBaC2gDqNjUourYLkhhNlNAsU2XUauK+fb7T3+10+jNEWopsbBi8au9LMc4yWCkOE2dL25+hsKCtaCF1cFKqb7oXZCoRNnJbI0WVFefbwxGDwdOAP2kguP2TmampITC2TdSIf0bLhBLRSS1Rrho+j35l4msw8EiUBepjAYLKqopZt2y9BwAuGCxSGSPGTfD6JcUIDbWSUND4352q0qZwStOsIBMmFVALUf2BNve4Bgb5flQ/xLTyQATJoqaGFYXIFFq5xt+RpmSecPoRjEhKMZ/jdEi234hd0euLDhd78c7Bm62kJw76nc03O3xzb/tNr7e/tfOmvzfDp8/3VM7XoC01bO5tIOWIijUuqldHuT+UurrJEPEIIYnM8hFSH28NWyToUyR747AG1q4BRwVH9TS1TPB+JVBb1/UybGy7Q9AZIHqiTSzCL2pTH6em+52YTxH4AgbHcIvI2LZXqN0up6KFHih8IU4xi75KEa0cKD8LXur6FcUvr3GzexP3zNEo4dy34/NfhUS+9qvadiF9XBgsUhvE3MxvIsRl3V7FWeZCSL2Zw57nmXBcxj2r4KLXOKrOIfBu4ytVrBQruD92UtQeL0k8cEhYfBa2wUQKRgI2tH0t2sHhOFJ4MVqlrvTcWGm/qH2WPGSux4lb7WE8NiXC/Wk0cdoUAPguHWJYTVRnYMubEZzXYHGUnAvXvcASEI9n1mpVei81IbdJduQlJ+T8bu0pr7kqHJC2a4Nz8o3D21cquukyG4ylHvpTqy4rXXW8L2yc11QG+y4qDRdlULDAXJNCS5cM/U9NCNaLimp51a8hXecav6LnntdsHb8IaGyRGvGMCjFQq9V87dye6x37/zb3Zi6dDnoLPadIt82u0N+4nJbQ0QxUS2pSRx5tV0m58FtDfxhwGB4CUvabdOuaDuJf/5qhYMgcbGI7brwF25Eiowq/BtIW6tBN3+Y54vvWaWXXNal83cxCte/MHJO1CpZxUrZL2vRB+aKcW37vaVVyvFQsVeozzEZu+5ig1jFLJ9P2j8Wu9kI0U2k72op26nYhVfBMmYXhZ/daheabzm5zjV5mirqQAYA6NALQ123ZoqYNk3AQNVmCYJ6gygrsGCwA47lt667C0k587gRsFfx1UNWACIvg3DHUkQoqyb5QQxbmdNhCMrsibu+cUqlgl1hlWiYUhQTNoIqlMhNhx1hTD2RX7bkiK/JAZ3W89bwNHRnqxLRL1moCrW1llqVYmV/bWWY2RGt53RbRYcdakSe5P+gNzJq/52jtsTSpnZ7cj7DmbfBi9sY/f8WYpfuqYmxVMbaqGPtBKsbMnbcsFojbF1g2ZkB1eS7TcKzKxlZlY6uysVXZ2KpsbFU2tiobW5WNrcrGVmVji5WNGf3yBZeNEYCrsrEXXTZmuegLpVGY1Ew+J79wqXzVVGN5VNCXCmnv5DnNBn/LErK5JIqeSKO/QQnZYub21iyAjWe2iItggToyK2+mYZgpmJkFaC4TPUsdWegYWNWRrerIVnVkqzqyVR3Zqo5sVUe2qiNb1ZGt6shWdWSrOrJVHdmqjmxVR/YD15GVQwzfqucLXoSf3ZcvuGZn2CNkknKtUTFjC01wvex8Nx6ju75TIu2OrOR3yNuZXNqFL70CCG5/f3Lx8Zh1Ly7+1+E/Lu+6x6xf8JGA/hhdZjMphZAfwL0GSbWwhcNkyHlrTxbWReJ8kCdH5212+usv/2zTyLXXLqccxQmjkco8yFG1NCwKg1BUorl9HP0XQeRHuYbD8tDsx2r+fliIPXCzRrWugehyTY5yHpeXa6+j2lYiHpKciP4rJMPMppS0VS36GeV3sPgRUoPTW+pgwhjFLDGVjvIVAU4b5MTpjfIUGevAYaB4auhVrXu5Fsy1yyBoYaiaJGCAvvZTLW79gGw/f9pLupbh82n504PgU6z744LGNdizQ2t+cLnjt2BtYwEZhqDguz8wt4m5s57SEfvFb2fXs26O2qrW5LNJ/3RudrZJNrCaCYYMIs5ArmVeMon6wZKEi/F9i7JQqJpBYX/ge8H/L/lgAJCUvcSNAii8mTPnZu/A0pS2Ndw/SUxtqV3jZ0fcf9uZvGONqUfTssUxMljZrNKumeLslbiL/FAjXpY8/hyNZFkIJPdtmD/RGxfdTqeztcFerzWRzfx2HsGWqCmu1XjdlSA8lHghrabp+BWI10y72ankU+Rb9kQwYju/KY34fEFEDJdvJuhDV5qlt394vskV91L0yzSeIWxIFY/GYmR2f6U3LjY7uwcbzcSl391DuR/QN7JWq5B1lHjATTGnGB5deFOe4RT/P3vvtpxIrjQK3/dTKDwXttcHxcn40H/0P2GDvdqx3Ifd2DNffBMTWFQJ0LiQmFJhN3O1X2O/3n6SHZmSqlQg3IAx7T7E6phloErKk1KpVB4yLrbkaERNtYKOXvlioEPAx9CVPnk1T1cDzktRR0vT2aXv/MJ5Xjov//4jBFeT3tfSTODuNOrJhWIJ2XapPkdzd6ynkb1arT2mqILq6n1ZszkCF8zvQbEt1lorMvdRxbUN5n6UDyzpDFkcb4i7L0OpLc0Gl/QOR7bNhtXGWIZVGZtiNeO1ueos4bIxHhtbKQIO+NjcOg+kCoopm30ZTpT1frstW23XQsJTxeI+nnMhDknAIHCvT+i95NiCvxyxcTrMuk7mh2ANxOegWc1zp0KWmFREgCFmKljZfRDy8ZAlWxLUDkYOES4iPLKbWD8NghbRaJLYEMDQZKM75PaJzfVVp3vear89737qnHZ/v7x+2z0973Rr9eNu66zV7bw9rTcPl5GWAlUwtC5w6LslCn08f1dmAiKQI0jDF1GZxpCF7nJa9kG9mOVsU0pBFGe6taLLSmfvjiYp/lFmn6HIA1wwyT65nUezGw4pF7dEcTitpdkVemFgDCTXJZmyrolwN77ACXIZBMHTGaAh2xIbMt+zyw8HmLkCFAUOOaMSqEvFxWM8W4tPea0A+J/lFk1NwEOeuwqz9XmiUhdAmwWLsHm5tvvHjmYceM3NX3/urslFuNEKRlFzS8xrOYj2wamXjBNoEp63O37XbpKIo7dP9kn7/FPG4/lqCQQ4sMTygyAnTGNXKROhiSvRTWsBf80YlUcawf/y9eVkG+t7N1D2JtLZxkxjhUkvt6oXR4eto4t6q9k8u2gftY/Pj8+OLw7OLs4uqq2T89ZT+KaGtPZiGNd5e1r7rjh3ct44abRPGrXG8fHxcbt+fFw/PGzV2ye1Zr120K61a63W+Vn99IkcLG6BX52H9eahn4vOqMSt7fF0LhZH1hzd3Po7PD66ODw8PK02D84vaken1ePz+kW9dlg/Pz07aJ21qu36YfO81j46PmqenR8dnF00Wke1euv0pN4+vaiuyV2u1GRrtls7r8fEIves9xcLs2g+DZH9hMaqyztnbEKK2SE5J30Ebr1/YwrtkE9SpqR1WiIfbt5cin5CVZpMQrzDu2Z0VCLt1hvzHv7tRhSvRt6/aGNLtD01QR9DmubhDMrAYSrTwbliqJs2TMmYJSCmIJ6dzlXFPWvA/4ZURGpI7/yxVNEBa/Zqx9Fhr9kMj2r1o/rxSaNer4Unhz1aP1hXGoVMu7SfriSQUVEoigJHU1a5hpt+57zwAMU5TBEVVylg/UBMp2BGDWA1GWdsXPU88lJkt16t18pV+Hddrb7Gf0G1Wv2fdS0eIdNuDwvGfSViGJNvZULUTo6qmySELobzzEGOBUqdwuEEqvCBASVI5/2l0fMpi+NCM3288sf6bQAe3OW6LeYNPJleAjMdtoXRODXxGeZgSlIZkN9BLp3thKs8oLGUV5MpjD1gwKExN2Vl3FweU1jGyyOMk4eQYR4GoVyXL1pvb4knS+0dc7tFvkM445LldovRVCsJ3CbaMpyMmDBpjhveJdRkrOMTutqPobZE0+yIaqb120UFhwpShICjU3rptvvHzgJvSr152P136x14UxrHB3AmzB88b7UfezSbaOdJZ8jPzepJQKGuLqS73TNUKdui9RUUo3Ik1oHDJMLsdU7f7we6egjMB2ZlMgVeOALtDE+gPMFQQmte9Aa6Yg/vY9VqHUulUzcxQjNPOYZqzu33HTJLBUL2TNp+FNIkUpCiIaJiNDpTfu7/y1ErT2KTtgIhL2O0MCn+WflkgtCAOGSv9X4ftA0ABSvBpXTGAy9BrMUJBxbyFgLSTpWaJJARajvQt043QiesprB1GuGsZK+1j2UflI8EN50N4OdUcmXRNsXBs+3stdeVhtabm06JfMjOIZcixM0Ft2NzGRLKUck9q3gkxxmabFSKoLJCzNNti5Gd1urBq30f8d5BlRDQYL9x9rABZN1KcVtG2J1akb0PT1QulyLcMD1o3J0Inn5FstAYCgWmQJ2bNckzs6o2QCIsatyVSRdDQrd3OWsJZIoqJ8TOn1kO1yXSwYDTj96104KOkDIRnD6FCps+neNZlKam2OXMBcii4/gjJ896tV4tV4/KtUNSbbyuNV83Tv4Lj59PRXqjx/AvYj177n4U49pJuXqMGNdeH1Rf15tPx1gnrnbv2LRLY4i8ToejFXB/iqCf2vnyQttMsISmhZTaO+Zf8J86pxvCO5wk92xLOEPYC87nBFgwwuIYHgjNTznmJOOJ/7o2+zkrcO2lleAqHTfrtQ0RjH0eS+FWSfkyzWYqgxRocm6Gy8QgYgm/nxOC7O5zSaQPm83GkfMDFxH7PIvp0wih+D9sQ0QAwYDhrHPDkQE1piH4NEmPL8gbqFcPjp+CkmIJp3F35dLCG0jG01PbosG49eYeCe/uP3txU7xn4/1Zr1o8HlIxwaqbjmOteHEDd69Qfz2UMRhpcCLObnEKw4dDmtAQKxz5GNFsXpydnbSO2udnF9WT4+pJu1ZvtU6fpKkUHwgK1xVbV9CXeaIkRHC57MiAcjXU7xB2BEdpBvRTbtUC27kGWq5MMJiJ/FuSKyoGpJVMx1C/n/cSmkwD0mEsC+0a8HQ46YFjoDKQMRWDykBWerHsVQayFtQOKioJKyEOUAFi4X+CgfzlqtE4Kl81mg3veoFjVvOw/MQtxDh3XobbQmV+CwuWD3E1pAmLgkEsezTO7GXB0s3Q4SW4JXxo33Q2gd9LdEvMqkkDqyl/6pUB7ZfoXL/JzwklcvWmQwUkyomQq1A6fosSuRRhgF6KZ5WeF+2OKBBnE9i6p98tY+z1R1i4fMgXBGHTyL9A54OHFmuj+oM7EUxEznYtS6erEABhzDyvaDdWRm6LZ8UFwc/56TErogKtD01gdklf8tMxdiBZVB1HsXBcbx4mK58ImUppDxLWWbQCFXpSxoyKRYie6Z9JP6YFdE15OgihF2wgU47OQuwKpXTtVuh4TYU7oenLw+FJE4MvCBNoI8LniRAsDlZFW7DPadcG5a+A+ObZn2UG9Bh+hfiwKCAfTU1APPhAYoAzrg5LwMLJuuxeMiV71sYGzymngmK6K1Vg2cPdt6qksSojZhBUB8uyrMde+EPweZiO4l9oPBZlC2eZw53iDCzQXFILd34YiyGpBpsYeiUWoK3UgpUFNmFqMmLRCjx7irByNZPwgcJqYMCarM6wUDBdNyoGSsxI+coiqmNU3JDvFXDecgaCgXWdDIR5NF9KBsIiyLbEhufOQDDoLZuBME+NbyMDwcD9XWcgGBy/ahz7pjMQXL59vxkIL4Fzz5WBMMPB7zgDYUkuflcZCAbnrWYgdIwzbLlcg7n8AmdYYqXTR8rt5hoYQP6iDbUlMi5INtCAbDzZoHFycHBQo73D5lHzgNXr1aNejdV6B82jXuPwoBatSa/nCGdQKR2N3TMAHr1NsPgS0Q3LxthvLNnAocXGoxxWIcZs0MOyhNhYsoEhhPHSrUCFDaqjLysgK7s+WrTezwcSPrvS+RlT/bJjql02/YypXhxT7aXTdxRT7cHvZ0z1xmOqPVT+fmOqPci6l2hbRth7h7nVmOov0OMHj6nGmGoPiX7A61CXCj9MTPUs0t9/TLWLsRMh+t3HVC/A+2dM9aKY6gUE+/FiqhcQ4luOqXZR+hlT/cJiqgvM+RlT/XJiqguM+YFjqv10+H5iqn34/Yyp3lRMtY+6329MtQ9b9/S7ZYy9/ggLlw/5giBsGvnvKKbah+oP7kT47mKqDUJbwuS9NlsLPYUNBPAd3GVnvfNlwgdc0NhEq3rR3a0F9d01Ud52SPF74FoM3R91uC0G+lgYELQCCZZBP43VMshbxNWYiryLhB9XH54LcfS2xcyiFLJoD5jX9pyD/V2FUndn4qmCiPGQZS0wT/XDCTPXsXB0IXIMielcZoNQeEso6OkjnX7hlCTs7wlECUH1d4HBdGZc07QN9QMFBxeFyAny94Ql06wtZkbXRr9/Qo9Pjmu9ozCMmvTVkgTWuHwFCs8SET/rcvlOs3Xd/Mz0zc4JaIJTewy8lSSVAwaEK/b3NiObXqaWzEMqolh7fbJJoFJ+UjZB2Cyy7fLUPJUPev2Ter/RPDrqNQ4iekgbITupn0RVVmUHR43DWdJaeL8Sge30S0uy+45pqA6NEoFwWYt/bIw3YlRNEnNqR/HOxNWIthmzKOB2Y5ojbLXarx4eUVrt0ZNqvXdUIOQkiYvtFW4+XS3RXuHm05VtnGC69BFTSw+2Izi4jWNmdmaapOACufl0pfTlu3kyV1ZAu17CsLk6iaAfPRepJCocMmgwrQsqlsiYpkMzgiRSrN4vYbvdpds4mxWZSRLnqmmnWAfS7Xx/KYiSI4bZF4pQpPSITnWLEJNDA6XkRFQBswcorltXx9NS5uehs6gC/gG5NGUuYWworMmcAArygBGMAwlzwE+3po6l5rMLoUYIADNxGABnzFOW0Jhcfrw/zMZkIoylcQTf/nELUJPbP2/J3uX59QX5dJGHFtePGvV9DZP7YO6Psn4t7F/QY7b3J7paXHCzETXYdjUsU4XTikmWT7MtSQGvOYKbExRQgq4gOTAeU8gs/2xclDGM/Y9suGzMKP4dydRh4fX86Byan8RTolgKTkaemhSNEsirkClh9yyZYn+aIW6uxfdnBrfTjlnCZURGE5XiID1w9AJ8LCruKHm+lH64x8jOWAycEpTw+k4A3zlzvZepyVzAeL+MasBICJV0djsLKbTBNUf7lCbB4J/9EmKejQmDgHAQyJzJvamZwO3tDP7ZKSE6O3qEnX2/nI3FYE64+gkdjFa7XHiSbH2UScqlq4YIXnsiwW9/uXWUUirHLm1BSG5/uQUnM+DqGukWiWB3Hr9JHD8fbi+y7d9lH7EGVa0bFPMRVJU1TYqncoJdm3OtO3WkSqVyNuiSC3I7SeIAxrzFHFIwwLTmRsyBI+CIFjq4kEW4zWoL2apDNO4Kw+om0N6Dg3VHF/Xi64ODRkUxmoTDX/9+Y77Xn39J5fjVLOWtWvrBuL97I0YyAvs7yjUzLjNFFGOiwBHTiturwbgggqXaBJSCpxKyMvWWKHto3EWZNdCDTl9W6FBGEpbZhShCFJNySSwHmKmg92tQ8v2UCfIX6NjsWGWSFdDAKigAV+KyrtvZa9mwVEExH0ivtICWCiagkKlfQa4leCDtC35+NSsPY6rUjIRtWCYLsvDRTGf1pNmgAw9c6XBrMKXDGXgcnW8IueMBUSbpCiDOXP7qNJjXxl2xEDaZpAthOzjw324dHDTmgMWT+wrQPoWgu7Ax4oRmcWgR6DFttelfTE61D7dsXKrIzowAz+2/v+L+q2066wuanSUAk5wWDXIhye2vt7jynWAf3MrNuwh7YKz5BEOUKLyDkdb2qZIzGb5grMJsRDhxgFOGjcZpDg+Crp+8NW+bnu5ZDATHbDoBKdaM9Fj6wFh+XIFJ0wdoBaAcV0HObp05D/FE3e2e6a6dU3sOBFrF9pQKNBiPWZS5uyY9/ZPD2jnL1xlLP4zH6J2+lNk9VChHO8CkHfeLgrhkYQGG1tAzPxlxwSLIpwq5YrFJbIPjGvgd+F0hDEVN+n3+ORsRn8HaBK8rFf2IfiKQyWA/INfJ1HRToONxIj/zEfAS7ZTelCg+GsdTkuKpft64BvbGtMdiRR54HKM5jXvfA4tjxP76qq1yRRXKYHK3499GHIrMiYp2ImxLPjo420KVtgPEVC7X4ACjw6ZuX3tNbQ2/H28cbR5jK3DbQvralXIyzg8c2kSZkr8ncNvCc+GGVWwPgrnVQuPYYg0PKMI+h2yc4hfQ6QPxIxMRsWRm0RgtEBBwcVDrcnLOdLMQoOfX1DSB4c3vkC4lRe6PS23nY5w5pELIfE8srLCSQ4FMCc8hBE7VhxnYDUR+7UDSRbTVLiSq0mA0NSPoJQJCtMOoSneCWZeNGaVwLkZclbkXzHSYlVc16dWhgFKtoIbyQ3kRPL1DmMONoYIzxo52UMEWlCaUx7mDYMGypo5v4wtGuRX+VI67iNJX2BhYvw+9TiG0T46NEBnK7LHrqzb0cwDv1Z0AFydVDk+ycbWCLVkPMRy6CurAjAfIehwos/Nmw7pdf0M5guF3vu39A/eORVtHzonlNxH8bU6e4IJji6EwN2a6mR0kKPrsFUtmnPb5N4957VFKASPru7eWK+FCG+zgKaI9KL+a2kf12RQ8DjG7p5lTwXiJ0c+QSZHpugzyM6TQ/1QwKH+YTEHd5q44kSacKWO24iSokmQC1gPc8Am4dLFaxl4wUEEoFlbREJndw9k1RsHuylcC4ZCKAVPBdrXFe4fF2nsvk2lOcjTFRwxiCojs+3cGuAghV+3Tj0DaUy3s7WwoV03srqpDLU0wSXJLJAHBL2ZlBuuCDRv2M4evbcURNUefXZUbIyVw0mddygKfQjuNeyxJyTkXKmVcrEtOVCgvZn0gNC9lgSAw+f3zc5Nl/r4/qzoIgNjm9WqqUjaqjGOaglJfex1p7La4+blc15OvC/pMyZbnBNoGCdhtbAjejVAmuul/YWMFbpn9Dhy0QorpCAKAsqE1u7KPN4pBUUXeJ7fwUsCjW5Bl/QEQvrVHDfj/vg5ToHFxMxeR59wCXpj1xd4n8GExp+w5hN1IBmK/Luh+ad4E8NvfEjpD8J7C2CAVsRxwsYhG2Z5AcU9Yl3qJjJmaJ9/z1tADXAjODOmVgF3Kcz1h7E8Pqrt/7NzxHhW0S6MRF9D3MGHonBCDLgy6RkW6H8JKtMhmh6ifxrFjHOdU+cbM4xzwnwbyRgzknKA/TeQ5E3mWON+bkTyL3/MupwKGTzeTc+B/GsrPYSjn9P0GTeUc+J/G8jrGck6/H95cfikWkoXnezF8vsiA57ONLBY/kslTxPmbsWSKYD+v2C9roFiYftodK9kdlmwvyZywMH3jVsKqunQDhoSF/oe3D1KaDFj6073kuJcMSb4x35KB+qdjaSOOJUPNn16lOa9SgTLfjCG2DnLPu4oK6D1irq0G+U+jbiWjbjXiviTTbzXIv3EDcdPW32rE+yFtREsDeKVLB3kmoxPuSNzvlwp61CPZ0EfgNoSIQ6IaJLTKEaGkl8gHp6JFphWuh2xqMufUUD6QCXTXIA+sZ+tZgCgoGAoCWrMkHVPAZZKBaxNkVo9TjBhM87W2BTO7j+f841CKefH9SoDmpPYKaIf2acLngP1OMlcLVLkRjvR1C9Lno8w7+Q+PY1ppBlWyp3n6/5HWxxvDX/KhQ2r1bk2nDL2jIXzx3/vkdDyO2e+s9x+eVg6rzaAW1Nw+fYTs/eft9burkn7v3yy8k/u2gFWlVg+q5J3s8ZhVas3z2sGxYVDlsHoQ1ObZpII+HfF4+nx8KpDwQ4fo+ciejSBPWDSkaYlErMepKJF+wlhPRZDYICL5oPa9xNVPe/H58bLlP+jyTWJgDGZ7qBJu2QpbBQxryUS6qptfbrUovpN/0Xvmo/AdSwSLtyUxs7jp2bNedFg4I6EPj63Eg+AgqJZrtXp5wASUR/Nh9bzq9VuSG1vyxpGaxwTlv1/NoiJVYI9sz0fRxzGx8xs9EzKRSlUik95EpJMv6RaaPHDhwwrEeUsY7d4oSN1g5NbMe2tywSAWlqYMSij/o5+Qs8hDqadsXCmyrbyXSBqBSTViSchprHUx5I3kZ7UP2eMKOi/GsXyAkU3f7bzKBpy8yF5WZ2//NYm5mHwukRENkdKCf86T2gy9g1ez+XMfOmQqJ7u7CVhEFPPXQPxs2qYp9ABpszpvupAPB0/0coaM5XgCEcLQLjxmVEGpHigRj9lhUEJNjpmAGSiUC1MTpnPnzludEpx1x4kcS8Wg0lg2JI0i7LUe7PoEBVF9teTac8TILKotSdLc2jDTL6U6a9Wg5jMctouCU59zCZMUjKDCoec+pqJ43Pnt6vT9sgcdeNYecWiS59ubI/2UHFfrQe1vktLBnsJysJAuG96x1Mo/VTqPDop6iAG4gzGInOk/cXyqlAy5qbALQwhb+COBcxGGvgMFsoVNs1YGZjK4O8k7s2cr7b2ufxIADXxYQNWbJCKUQEvf2GCb0gGm8wKx5QRLG9FB3nQfvoY6AoDr32Uuyn8TJkI6VrD8AIOScRH5ICOFmibpdMxDJ6/YZKZheTOaFW9RTCiZkD0WDALyP4zdlcjvPGFQm/xuHyuS8HvIpMyOxuhATGgfOz3MUIILwZKFXNVDEP2QQS5nsCJ7NufOjGp+K+K/vwDJx9HT+JlxV8XyEfS0tjTjQl2uTH9zkWk4kAXhkZVU2q6XzJIjpYMBmlpmyA9GUANXuA32SeBKudlFPPJnHzdDZrLtugCxHpl90NbatI7DiKswgeI48yvMjIkcd8ZbxJc+T9gDjWNVIgkKv8K1EMPe2aMx9OZL1Br+hq051xHRyzbIoJbmvD+GpZ5fb67cFmiLLokPY1N5G7GDiVfGT05SaBK1DJIWwftJDP2FetypM2+3EM9Pj+0lsJUUhlsiY5h6ASBzCcSmiGXBjbi8YBpzcrolTqL3EIaB7cOYJLBbJOGQpyyEviUawXSOYhQDAZ3L1OshU8yW77I2fznTHntOkn6JtNFnAGu5c9M534c/8DxIY3zw1YzAlEjbVjGWCbkwWmG/UCOA7Nl6W1DuYqoGE5pEgf4b6jpU/n5gvSGLx5W+7IKo0rgC1mjMogHrUcUqBQS71rJnKhimoz/+Fw6UAVYkRv7sn/veCmO26qPN8vYbsbt/7Fjc1og3CGPYlmyZjy1JEAhQcWJrGRYppEKZ5HZvgXHO0CRnIhQAwfZqUE6hEt4rVfGXuv+ts3J/EAeD5yPTVtwMc7xwvvAzApez2WNVZnLQGK775ub1jbBg0YX3LBjxNGHIL6yyUOnTv3HxxL+E96yLpRK6DoCqGyYMDol/tLAVTmFqV6dzMFREhL3GFOik1m/nrhj+6ZWMSwEH4A8donsCknpQqweHpmgYqOwZlW5PuJ8+toIgWFWmmIBy/9teelZ3O3eaaM2BssVSI4tZ5192PjYuWHfnq5Joa1YXUMZSxCilvcv2vi07Y9qVFUo5FejkjEuwnsc0IJduUQ4yKV4lm0nMwDZ+Yp72xYFXW0IPQ5p2uerCUuLRvlkzBduHF/JXyfyauWz/+WoOAORlWfenrFartVXZirW72fa6o5yShOlCo4uVWeFsYTQbRO5EZMRTPsAfirSyTLMsZdEM/3yE83MuHPByj4tKeM9A8INwwH+FP95kdD6s1dYgMwhsd6uLyJzAZUIUVL3xiruXMIBlrVo7DtYRKJhLsCS4ZyKSyRbRdWsTFZhvQSIaJC/K10zQXsxWR1YmLOgVWxV+CdF+LGm6CJPdDkSfKCiMQBJIbdf3/9WgCmeTWjWomkpl8CfpMXsrNYIidAoKoeeNHQg5A6NbmREl+MDAhlWKKQU1stG7xD6PY8lTS6wRSxMeKrJH05SGd+Qeo1BzD7QunvuZp9MSGSf8nsdswEz3BBPJBNX2sZnEfonw0ZiGaT6qG5cEY2TjQjeOAXSZ1EOZKEaEad90VoBmLAsMGI/BaQ8vqC7KkQwngPK+13ZvBs31WM/EPU+kgJFp/DJl4NwF8UvCQMWUZCWjUXoM50pkHc5hdVmeMJhcvTDWpQxKob9Url0b6L7EMOi7SEbQDxQZAKSOuFMqMmcTrCrLw3Cz62gFqm/3vgNdJO9trznX2prmTom997+193NDBJwOPKVQjyIbFhUrvJRQATX/sCjlzpV8gDiydyzik9GOVlI7b/lguIOKtfVbp0Pu66CmMzWcjYgSAtcYmeFjm5s4c4FvWjljNYKqqc04RV97xPpQQjcb1JyB8ocLvHOkC5/gisgHqKAIcI+ooAPt/7u4/NS5Dj4kA91/kuzhF6CEyU2n3KNwRBFSlMeJ7DvtA93GflD2Ha4AR1wp21RIEvDfwK3pGJzBRLEQhRYsc5DJFCzDsRSO6KSMjhShYSIVYk0eZBJHC0RX3EcBdNsOBvIevUFlo7pQp/iVh73gWkWMDXu2JMHXrkR4rRvQN0hZVC4GZ5RFUMxJMXaMwJ4NnYwNo6BQLU0wtsVRHetR2EfgFkwV0ngZKlsKQ+fAoosYviGtvNfqEl7iXfTfQZ1Ovfkg4UzTXlBE1mkMiwuHd1q5qkLHd9ebzBVGi8VTCKocmD5Y5PqqQ8CkgmNJiUR8wFMa532a88bLZkT2mYWTFOxN0uOCguexRDqVd5fvzgu+ay5M7ktPRvgMeHcFeDJh6faxnYyFUuLNzV22xn+3PWfcFrV4+wm14KVpklOC67o8HgBjam9hWGyleRvgMGZECGVnylrY7fNPZSZg94kKU4BaMhaArfx6C2/eYis7bOFTuEbrsTzcILvfxfs7Awi8HKghrTcPb/cz9M7vDVNpmoe8O2C4ZMSzuL2Tcy5QVakIiiUFoG7p4VZ0NlcEwG3jGCS3aawCc1MCr92a5ldmRPw5jDncJuDPa9x20RgXMWxHmL+ktqR4sjampq2xA0fWyPT0/X6gY1xhPkXuaTKFHcThhDM8yTvJA5kK/IL3sbq/XqIY+6zvI/M2YLAC2u87ZJYKhOzBcA88jkKaRMocFwppY0wFPvW0+y+nt8fuMkrKZQ3GviQvowl51oMcgAJ17lI3o/tjTYZJ5+1pnYD5Qk6VmkDLZUY6KNukdbo2bV5CY3LlQ/umsyZOL7EZ+epc173Is17jYOqVyIebN3ACMA+BZGP38UckxBmaPFlaXnQj8ndyojXSb5w9rImgayNvGUlv7/H1lcWlCDdAgxfYgnx1ksysmDXJ8gO2Kxcy7WLDvxVQjYo2RQGVaw7BLCl5GPJwOGf8YiQs9oJi4DYREMICrWzvacwjL2Pr1Xq1XD0q1w5JtfG61nzdOPmvavV1dfXwHpl29T3mtjBFH86yWMLd0TFiWXt9UH1db66HpW6j0L1j0y6NIaAiHW4rbP3UzpeFw+mAlLTQA+KO+Rfwp87pE3ANJ8k92xKe4A3A+TSeJliLxTEQIjQ/5diSjA+FE62ZVeU/Z44iL33AqzJu1mtPIBL7PJYiT4pco2tbgQ7nZriM3RFL+P0csxHpFRA9bDYbR84PXETs8yx2qyOv+D9sQ4iDAMBw9hju8FqNoZM6F6THU/9pp149OF4VDcUSTuOuvhxfAYkNpHrrqe2FP26Tmcj7d2d0W6HSUykToXNHAf9430RVQPCslorxkGLkBw9L0CYyz8rQ3oPUeHjAfxDKGAwnOGlOxmOdrFEYPu8H7SV+s3lxdnbSOmqfn11UT46rJ+1avdU6XVnzZG6lrSvZS3u5nqBL2mVBBlS+0gLyOzhN4HDMgGbKbZVj+37nrjHyb0muqBiQVjIdp5LEvJfQZBqQDmPZTf2Ap8NJD44llYGMqRhUBrLSi2WvMpC1oHZQUUlYCXGACvhb8D/BQP5y1Wgcla8aTX/XRjjWNA/La2wDxjnyMlwAKvMBWLB8yEIUPIuCQSx7NM7sVsHS9XF/CUd8H6o3nXVxeolH/Fm1Z2CFEKdFJxN9xu9cv8lt9BK5etOhglzA6Z2rUDo+AH3fgyf+jUvJiz7aFwiyLobuqXLLWHrP9hYuH8IFhm8C4Rd4kPfgvxJ6P+CB3FzOb9fac3ISYbs2ppdXbBtfQqgMy+k16TEM06AiHMpEfyzrLfpVVqjlTD9TAOX/x/Fbtkem2VvhdXM9k19t4c19HJvW6UAFtLndWxxLVSxwEUCXUGcj8dHNAwv8a0NTczjYR6QMBx4zoK30oz/xYiolFbN5nW/N/HYrMT8XIAVMA2hh8k9eN0UDSmOe9XCHBuGvTamdmYdHfADp48DWNJmw4uiaNuZJPazEJWq+0h+6K1Am4xQGnmFwy2CSIHv0ZD785pgwjxvwyn3uUbSQaF7uzg/sFYVHRwcCw0GIqQBK9jku9y/SCd1h+l1i3yU8soskjOUkytdDCz7aKJgEAvYo3Nj6l8g786sOTgwLr2JeQu6coFHUxQe6dkiYJGRKyWR2xRQwx5cCPqIDp1Z9pgroiJdpL4xq9caBD/lcSC5hBHLZzoKEceCMIkZEfiGnwC18SMaRK6wWIIA/wJcDi+sX2O19+FF2O3NYAPOg4cenyRDi0bozLSHBM3MtK8bObCMaDrlgXacGxOOTmRfcohHLzmXUNQZ8dZdQao+/teys40SiJluScebxXMiXnQcaNUux1ByFR73jW7UQyfCOJbleaNvPnuWlf0NLBXbLOGYh7FKoFPRvsMIVFKbrau2cWx52c9bzlTOdsGATzcBaLXrCRBFgh7rsRx+xHIL5X/ESbcFUoHFWnw3ecnedFWedeXO5SdefDpscK0J+Idcf2h9ek7fyASyQER2DklXsV2dYz2b/hQ3/EX2e63QNQmAlF7bVXG7B3vFL7aXoS1dazbYArxOraxwBhe+94mn2jfOWjQfCmEzba1wFLFTBdBQH5jmdYguPwLYIAZT5m8UmygaRxyV9MWsKFUTtED0pY0bFkuTt5xQBP6nD9vl5pQp6Ex7PTznP0Wz33qkdt2vVk53lwIEsQJjBDY7zAwKeIO86eAwWlSYsDYfLA2Nn0Y1uxTSTwLtJD4pqpUzlcvgf9zvPuPnvmc1VNKDyQXPD6YtaNX/pi5o1f/SLMjdL8bGMgiXJ/QhFHQqMZYRQzTMXpprwaGMzfZQRubls+yfi47l5+HitKS4/zs8A/8XLmo0hk484P5mM5jaVJ05mi94tmGzmdPP0Ce2AvjoVMOP//d//RxFTS28OJLNH/OvJu5Hzc3dEx2OoG6vx2vnXzso4md1zRMfzVMSWuLjpvzy4Hdj8wCsGRqBM1gb9/1F3tb1t20D4e38F0X2YPWha0mHAUmTBgqYosnRJ0Rf0o0FLjKRGFgWSSmIs++/DQ1JvFmVLrlx0QIEilsR7nhNFHo93x4NBr5C5gQuWpwk29Vor/xp8V7PDxNbt9nw0IctTvl6VLp3JBNft9giG2Y564pNTbjTcI7q2JyYVXDVrd07C5FZnN6MECjXh8PpCVQVZFBkcLPNtCCe05sey0I2UxoWdx2vL4l31g6Nde7G2KSqHhssGqNseZwCwx6GasRL8Ovdhy7LDMv7CU36X0J9poTjKUiGBtab/l7mKLTOdHLcmzfsqd9QQB5ajqaYFZnFUTfY5eu19vvHytTPdXB3bgQv/Soe4DXzgtxUA67Ptl5mE48W9pqgfjJZxRnyzPIINL0OC0ZIRlqi41mtIwsLUhFFUqCJvuZXhHIcbHz/S2i8LySSngq4YEsC4sFmQ+r0xlCxioY+CluYH/OnZtH4NTedB0RRNKGliYS7fmTts9yJJ6OHWGCZ6GxICNxIltWbcKrR5HLngYRGo8YoEnvrbtc1giVBx2yZ27+7SEvujLCtKkllD8nyH6Eaq/kjJ5tlS1TX9Rl+QRBSZrheaZG4chUj3k/7p/VsSw/GAOC8jzvZWjWSb0oNCVMtk1xK5R+rnmKm4xe+ByqqLW3cCIpKwLW9LLXCBCNBqlYjpUYZ2j8oOZq3fHKI/VNeRnl2kppQryxBAI8ini3dkxaSkEdJy7M6Y2fuG8fzy9+MXv80xBypbiRaZUwyfrpLaca94wFOfPCv1WjUGd0XAs3umjW7FzRaKzb7CJ8Wz9r6Lzjm8R/obon4UJ69Nfg0J0kIq60BwDZ4GzOgZaFmgMmb1c/noRjGkngJMlQ+ABjqeRPrtN+ejIiY+fP+WJmkhmJ/xhc4rWui4pUZTPd0F/6yaLdIOg/7l4DASIQ8Wph5k99rWXdhd7Pdg11jbdnhKU7a08bDrYx+Ounw3+8AssXRAmlOcJsNou80+EA0SMst4kTnsUzqtMr+6X1tAZJZTqYhimXRZ1bqfdiPdebFMBypJt1D5bM03hTRVnmKgKjD6NKujSkwJFHn2ikVMSL+LSVD1dZDOowhZ1BggLQKsk0N9MI2QulAswq+ZDTydlTfpwolS9xRJZubuOcmxocICjrH13k40t2kh42podXDQTU5FooO7NkQtWhQDEaLtPjeXULSAOQDKYnUweLJYlfaHxecAsGo7v6dFgMaHQAiTg4IIkyEwpApDdn8wGNgYDqkI9cE8rZqSWzSTZAfDg8r6KLCihbtk08eDyY6TKN4qHHHkmHDbB2kNcfR1IE7gLNtGpWHhNkB7RGfq5ydHGMvyk5PFiYPlcx0wv/jp+XfOsf26yJKlemnBGoyJilFInKehpW6onRy5aGNY+P5ZOwavYdTxYA9zWay+f+LdeWMYb5Bz09bT8HjiGwk/B6bdmc2HsTbcnLxNO4thhlRlch75x8PI4F39edqwL85sGBfqamCdCfduWahM5+v45FXXFKSpYDREMWOaGt/e8S8N5A5ejYCjvS3sHbyq8t1mjWhNQ0IiWkSMkDiRikeCruD1XMGFoiuo2KjT8oS/RRCz4M5BgOa54I/JyvViRgUEfGCq0YPqBCoSr3MmUh6lPCJMKi3KI0sW0LKaCvDGNCQrFN3RbnjJlPRX9HFhDuuV9tBel2GgRJHpyNep4P9tRS7ZGhZ2B8oDqgvjOBzzJkIHpkA9jvy+u11m8Ce+zugqCaxbfBDXdkysopH06lMf7VptZv737v64n5fHk1zwSDuZLuzxHE8/3L289xSN5j45x29VJlz1+gHKoSDdORuYus6cHRRqKLop6VXLuAX751R7zEjKskjFZ94pnCTlX/++NFfPnvTPZ0/tUtCbPqQmaP1c60qPF2aLJ6Y1vF3o7Tfkl79oDXQ7uFcDA/CU05NWg//MDbwLbx/cQyBB0wMQ2Qpjayeq7rewcwhtqRWqp+k48OYZdPSUP/SApikTqhmTOi1sRC6NA40nPPJABTznHmFCwM0uiCwC7Pr10GiEjk1CYAfIjzZKDN9QZ1LGeLGzs1BrqSDt5I6tvyH2N/AuY38eNdFCO9T0oDQVqw/XPb4wlJWU4whA+WUp7XXeLoC8qfKKSNNimGaMLo8Z1kZIc6yWwdMppJ49ncJVWMgx4/HGzvl0qg6XfpGPV3RTuS3GfT1GMz4Ig5urcfBvrjzy+fz99eX1G4+8en/58fLV+VsMJZ+ur65vPl9vZbDYSK6taSzXivVBPBqH8IjMbq7mHjkmMwt07pEXZFaC1UbKr2RmAc97ENtdq28zG1ph9tZlWc3X9PT/0+i80Zv/GwDWjPFf"
 }